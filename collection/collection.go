@@ -0,0 +1,143 @@
+package collection
+
+import "iter"
+
+// Collection 是所有单值集合的根接口，对应 Java 的 java.util.Collection。
+type Collection[T any] interface {
+	// Add 将指定元素添加到集合中。
+	Add(item T)
+	// AddAll 将指定的所有元素添加到集合中。
+	AddAll(items ...T)
+	// Contains 检查集合中是否包含指定元素。
+	Contains(item T) bool
+	// ContainsAll 检查集合中是否包含 c 中的所有元素。
+	ContainsAll(c Collection[T]) bool
+	// RemoveIf 删除集合中所有满足 filter 的元素，有元素被删除时返回true。
+	RemoveIf(filter func(item T) bool) bool
+	// RemoveAll 删除集合中所有包含在 c 中的元素，有元素被删除时返回true。
+	RemoveAll(c Collection[T]) bool
+	// RetainAll 只保留集合中包含在 c 中的元素，有元素被删除时返回true。
+	RetainAll(c Collection[T]) bool
+	// Size 返回集合中的元素数量。
+	Size() int
+	// IsEmpty 检查集合是否为空。
+	IsEmpty() bool
+	// Clear 清空集合中的所有元素。
+	Clear()
+	// ToSlice 按集合的遍历顺序将元素复制到一个新的切片中。
+	ToSlice() []T
+	// Iterator 返回遍历集合元素的迭代器。
+	Iterator() Iterator[T]
+	// All 返回按遍历顺序产出所有元素的序列，可直接用于 for range。
+	All() iter.Seq[T]
+	// String 返回集合的字符串表示形式。
+	String() string
+}
+
+// List 是有序、可按索引访问的集合，对应 Java 的 java.util.List。
+type List[T any] interface {
+	Collection[T]
+	// Get 返回列表中指定位置的元素。
+	Get(index int) T
+	// Set 将列表中指定位置的元素替换为指定元素。
+	Set(index int, item T)
+	// Remove 删除列表中指定位置的元素，索引越界时以 *IndexOutOfBoundsError panic。
+	Remove(index int)
+	// TryGet 与 Get 相同，但索引越界时返回 *IndexOutOfBoundsError 而不是panic。
+	TryGet(index int) (T, error)
+	// TrySet 与 Set 相同，但索引越界时返回 *IndexOutOfBoundsError 而不是panic。
+	TrySet(index int, item T) error
+	// TryRemove 与 Remove 相同，但索引越界时返回 *IndexOutOfBoundsError 而不是panic。
+	TryRemove(index int) error
+	// ReplaceAll 将列表中的每个元素替换为 operator 作用于该元素的结果。
+	ReplaceAll(operator func(item T) T)
+	// IndexOf 返回指定元素在列表中第一次出现的位置，不存在时返回-1。
+	IndexOf(item T) int
+	// LastIndexOf 返回指定元素在列表中最后一次出现的位置，不存在时返回-1。
+	LastIndexOf(item T) int
+	// Backward 返回从尾到头产出所有元素的序列。
+	Backward() iter.Seq[T]
+	// Equals 检查 other 是否包含相同数量的元素，且对应位置的元素都相等（见 Equal），与列表的具体实现无关。
+	Equals(other List[T]) bool
+	// HashCode 返回列表的哈希码，按 Java 的 List.hashCode 由各元素的哈希码（见 HashCode）计算，相等的列表哈希码相同。
+	HashCode() uint64
+}
+
+// Set 是不包含重复元素的集合，对应 Java 的 java.util.Set。
+type Set[T any] interface {
+	Collection[T]
+	// Remove 从集合中移除指定的元素，元素存在时返回true。
+	Remove(item T) bool
+	// Equals 检查 other 与集合的大小相同且包含集合中的所有元素，与元素的顺序和集合的具体实现无关。
+	Equals(other Set[T]) bool
+	// HashCode 返回所有元素哈希码之和，与 Java 的 Set.hashCode 相同，相等的集合哈希码相同。
+	HashCode() uint64
+}
+
+// SortedSet 是元素按顺序排列的集合，对应 Java 的 java.util.SortedSet。
+type SortedSet[T any] interface {
+	Set[T]
+	// First 返回集合中的第一个（最小的）元素，集合为空时以 ErrNoSuchElement panic。
+	First() T
+	// Last 返回集合中的最后一个（最大的）元素，集合为空时以 ErrNoSuchElement panic。
+	Last() T
+	// TryFirst 返回集合中的第一个元素，集合为空时返回 ErrNoSuchElement。
+	TryFirst() (T, error)
+	// TryLast 返回集合中的最后一个元素，集合为空时返回 ErrNoSuchElement。
+	TryLast() (T, error)
+	// Backward 返回按降序产出所有元素的序列。
+	Backward() iter.Seq[T]
+}
+
+// Map 是键值对映射，对应 Java 的 java.util.Map。
+type Map[K comparable, V any] interface {
+	// Put 将键值对添加到映射中，键已存在时更新其对应的值。
+	Put(key K, value V)
+	// Get 根据键获取对应的值，键不存在时返回值的零值和false。
+	Get(key K) (V, bool)
+	// TryGet 根据键获取对应的值，键不存在时返回 ErrNoSuchElement。
+	TryGet(key K) (V, error)
+	// Delete 删除指定键的键值对，键存在时返回true。
+	Delete(key K) bool
+	// ContainsKey 检查映射中是否包含指定的键。
+	ContainsKey(key K) bool
+	// Size 返回映射中键值对的数量。
+	Size() int
+	// IsEmpty 检查映射是否为空。
+	IsEmpty() bool
+	// Clear 清空映射中的所有键值对。
+	Clear()
+	// ForEach 对映射中的每个键值对执行指定的操作。
+	ForEach(fn func(key K, value V))
+	// KeyIterator 返回遍历映射中所有键的迭代器。
+	KeyIterator() Iterator[K]
+	// ValueIterator 返回遍历映射中所有值的迭代器。
+	ValueIterator() Iterator[V]
+	// EntryIterator 返回遍历映射中所有键值对的迭代器。
+	EntryIterator() Iterator[Entry[K, V]]
+	// All 返回产出所有键值对的序列，可直接用于 for k, v := range。
+	All() iter.Seq2[K, V]
+	// Keys 返回产出所有键的序列。
+	Keys() iter.Seq[K]
+	// Values 返回产出所有值的序列。
+	Values() iter.Seq[V]
+	// String 返回映射的字符串表示形式。
+	String() string
+	// Equals 检查 other 包含完全相同的键值对，与映射的具体实现无关。
+	Equals(other Map[K, V]) bool
+	// HashCode 返回所有键值对中键与值的哈希码异或后之和，与 Java 的 Map.hashCode 相同，相等的映射哈希码相同。
+	HashCode() uint64
+}
+
+// ConcurrentMap 是支持原子复合操作的并发安全映射，对应 Java 的 java.util.concurrent.ConcurrentMap。
+type ConcurrentMap[K comparable, V comparable] interface {
+	Map[K, V]
+	// PutIfAbsent 仅在键不存在时写入，返回已存在的值和true；写入成功时返回值的零值和false。
+	PutIfAbsent(key K, value V) (V, bool)
+	// Replace 仅在键存在时替换其值，返回旧值和true。
+	Replace(key K, value V) (V, bool)
+	// CompareAndReplace 仅在键当前映射到 old 时将其替换为 new。
+	CompareAndReplace(key K, old V, new V) bool
+	// CompareAndDelete 仅在键当前映射到 value 时删除该键值对。
+	CompareAndDelete(key K, value V) bool
+}
@@ -0,0 +1,86 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+)
+
+// Equal 检查 a 与 b 是否相等：a 实现了 Equals(T) bool（例如 lang.Hashable[T]）时使用 Equals，否则使用 ==。
+//
+// 列表与集合的元素查找、Equals 以及 HashMap 等映射的键比较都使用它，lang.Equal 也委托给它，
+// 因此只实现了 Equals 的类型在所有容器中的相等性都是一致的。
+func Equal[T comparable](a, b T) bool {
+	if e, ok := any(a).(interface{ Equals(other T) bool }); ok {
+		return e.Equals(b)
+	}
+	return a == b
+}
+
+// HashCode 返回 v 的哈希码：v 实现了 HashCode() uint64（例如 lang.Hashable[T]）时使用 HashCode，
+// 否则根据 v 的类型与值计算，== 相等的值哈希码相同，0 与 -0 的哈希码也相同。
+//
+// 列表、集合与映射的 HashCode 用它计算元素的哈希码；值为nil时返回0。
+func HashCode[T any](v T) uint64 {
+	if h, ok := any(v).(interface{ HashCode() uint64 }); ok {
+		return h.HashCode()
+	}
+	return hashValue(reflect.ValueOf(v))
+}
+
+// hashValue 按值的种类计算哈希码，结构体与数组按 Java 的方式由各字段或元素组合而成。
+func hashValue(v reflect.Value) uint64 {
+	if !v.IsValid() {
+		return 0
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return FloatHash(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return FloatHash(real(c))*31 + FloatHash(imag(c))
+	case reflect.String:
+		// FNV-1a
+		var hash uint64 = 14695981039346656037
+		for _, b := range []byte(v.String()) {
+			hash ^= uint64(b)
+			hash *= 1099511628211
+		}
+		return hash
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return uint64(v.Pointer())
+	case reflect.Interface:
+		// 与 == 一致，按动态值本身而不是它的 HashCode 计算
+		return hashValue(v.Elem())
+	case reflect.Struct:
+		var hash uint64 = 1
+		for i := 0; i < v.NumField(); i++ {
+			hash = 31*hash + hashValue(v.Field(i))
+		}
+		return hash
+	case reflect.Array, reflect.Slice:
+		var hash uint64 = 1
+		for i := 0; i < v.Len(); i++ {
+			hash = 31*hash + hashValue(v.Index(i))
+		}
+		return hash
+	}
+	// 映射与函数不可比较，只可能出现在 TreeSet 等按比较函数判断相等的集合中
+	return 0
+}
+
+// FloatHash 返回浮点数的哈希码，将 -0 归一化为 0，使 == 相等的值哈希码相同。
+func FloatHash(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
@@ -0,0 +1,89 @@
+package collection
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// caseInsensitive 实现了 Equals 与 HashCode，忽略大小写比较。
+type caseInsensitive string
+
+func (s caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+func (s caseInsensitive) HashCode() uint64 {
+	return HashCode(strings.ToLower(string(s)))
+}
+
+// equalsOnly 只实现了 Equals，HashCode 回退到按值计算。
+type equalsOnly struct {
+	id, version int
+}
+
+func (e equalsOnly) Equals(other equalsOnly) bool {
+	return e.id == other.id
+}
+
+type point struct {
+	x, y float64
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal[caseInsensitive]("Go", "GO") || Equal[caseInsensitive]("Go", "Java") {
+		t.Error("Equal does not use Equals")
+	}
+	if !Equal(equalsOnly{1, 1}, equalsOnly{1, 2}) || Equal(equalsOnly{1, 1}, equalsOnly{2, 1}) {
+		t.Error("Equal does not use Equals when the type has no HashCode")
+	}
+	if !Equal("Go", "Go") || Equal("Go", "GO") {
+		t.Error("Equal on plain strings is not ==")
+	}
+	if !Equal(0.0, math.Copysign(0, -1)) || Equal(math.NaN(), math.NaN()) {
+		t.Error("Equal on floats is not ==")
+	}
+	var a, b any = 1, int64(1)
+	if Equal(a, b) || !Equal[any](1, 1) {
+		t.Error("Equal on interfaces does not compare dynamic type and value")
+	}
+}
+
+func TestHashCode(t *testing.T) {
+	if HashCode[caseInsensitive]("Go") != HashCode[caseInsensitive]("gO") {
+		t.Error("HashCode does not use the HashCode method")
+	}
+
+	// 不实现 HashCode 的类型：== 相等的值哈希码相同
+	pairs := []struct {
+		name string
+		a, b any
+	}{
+		{"zero and negative zero", 0.0, math.Copysign(0, -1)},
+		{"float32 zeros", float32(0), float32(math.Copysign(0, -1))},
+		{"structs", point{1, math.Copysign(0, -1)}, point{1, 0}},
+		{"arrays", [2]string{"a", "b"}, [2]string{"a", "b"}},
+		{"complex", complex(0, 1), complex(math.Copysign(0, -1), 1)},
+		{"strings", "hello", strings.Clone("hello")},
+	}
+	for _, p := range pairs {
+		if p.a != p.b {
+			t.Fatalf("%s: test values are not ==", p.name)
+		}
+		if HashCode(p.a) != HashCode(p.b) {
+			t.Errorf("%s: HashCode(%v) = %d, HashCode(%v) = %d", p.name, p.a, HashCode(p.a), p.b, HashCode(p.b))
+		}
+	}
+
+	if HashCode("a") == HashCode("b") || HashCode(point{1, 2}) == HashCode(point{2, 1}) {
+		t.Error("HashCode does not distinguish different values")
+	}
+	var nilPointer *point
+	if HashCode[any](nil) != 0 || HashCode(nilPointer) != 0 {
+		t.Error("HashCode(nil) != 0")
+	}
+	x := &point{}
+	if HashCode(x) != HashCode(x) || HashCode(x) == HashCode(&point{}) {
+		t.Error("pointers are not hashed by identity")
+	}
+}
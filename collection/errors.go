@@ -0,0 +1,59 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrConcurrentModification 表示集合在遍历期间被结构性修改，对应 Java 的 ConcurrentModificationException。
+//
+// 快速失败的迭代器（Iterator、All、ForEach 等）检测到修改时会以该错误panic，可通过 recover 与 errors.Is 识别。
+var ErrConcurrentModification = errors.New("concurrent modification")
+
+// ErrUnsupportedOperation 表示集合不支持所请求的操作，例如修改一个不可修改的视图，对应 Java 的 UnsupportedOperationException。
+var ErrUnsupportedOperation = errors.New("unsupported operation")
+
+// ErrIndexOutOfBounds 表示索引超出了集合的范围，对应 Java 的 IndexOutOfBoundsException。
+//
+// 实际返回或panic的是携带索引和大小的 *IndexOutOfBoundsError，可通过 errors.Is 与该错误比较。
+var ErrIndexOutOfBounds = errors.New("index out of bounds")
+
+// ErrNoSuchElement 表示请求的元素不存在，例如迭代器已遍历完毕或在空集合上取第一个元素，对应 Java 的 NoSuchElementException。
+var ErrNoSuchElement = errors.New("no such element")
+
+// ErrIllegalState 表示在不恰当的时机调用了方法，例如未调用 Next 就调用迭代器的 Remove，对应 Java 的 IllegalStateException。
+var ErrIllegalState = errors.New("illegal state")
+
+// ErrIllegalArgument 表示传入了不合法的参数，例如负数的数量，对应 Java 的 IllegalArgumentException。
+var ErrIllegalArgument = errors.New("illegal argument")
+
+// IndexOutOfBoundsError 记录越界的索引以及当时集合的大小。
+type IndexOutOfBoundsError struct {
+	Index int // 越界的索引
+	Size  int // 访问时集合的大小
+}
+
+func (e *IndexOutOfBoundsError) Error() string {
+	return fmt.Sprintf("index out of bounds: index %d, size %d", e.Index, e.Size)
+}
+
+// Is 使 errors.Is(err, ErrIndexOutOfBounds) 成立。
+func (e *IndexOutOfBoundsError) Is(target error) bool {
+	return target == ErrIndexOutOfBounds
+}
+
+// CheckIndex 检查 index 是否是大小为 size 的集合中的有效元素位置（0 <= index < size），越界时返回 *IndexOutOfBoundsError。
+func CheckIndex(index, size int) error {
+	if index < 0 || index >= size {
+		return &IndexOutOfBoundsError{Index: index, Size: size}
+	}
+	return nil
+}
+
+// CheckPositionIndex 检查 index 是否是大小为 size 的集合中的有效插入位置（0 <= index <= size），越界时返回 *IndexOutOfBoundsError。
+func CheckPositionIndex(index, size int) error {
+	if index < 0 || index > size {
+		return &IndexOutOfBoundsError{Index: index, Size: size}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package collection
+
+// Iterator 是拉取式迭代器，对应 Java 的 java.util.Iterator。
+//
+// 典型用法：
+//
+//	for it := list.Iterator(); it.HasNext(); {
+//		if v := it.Next(); v == 0 {
+//			it.Remove()
+//		}
+//	}
+type Iterator[T any] interface {
+	// HasNext 检查是否还有下一个元素。
+	HasNext() bool
+	// Next 返回下一个元素，没有更多元素时panic。
+	Next() T
+	// Remove 从底层集合中删除最近一次 Next 返回的元素，每次 Next 之后最多调用一次。
+	Remove()
+}
+
+// ListIterator 是可双向移动并就地修改列表的迭代器，对应 Java 的 java.util.ListIterator。
+//
+// 游标总是位于两个元素之间：Next 返回游标之后的元素，Previous 返回游标之前的元素。
+type ListIterator[T any] interface {
+	Iterator[T]
+	// HasPrevious 检查游标之前是否还有元素。
+	HasPrevious() bool
+	// Previous 返回游标之前的元素并将游标前移，没有更多元素时panic。
+	Previous() T
+	// NextIndex 返回后续调用 Next 将返回的元素的索引，游标位于末尾时返回列表大小。
+	NextIndex() int
+	// PreviousIndex 返回后续调用 Previous 将返回的元素的索引，游标位于开头时返回-1。
+	PreviousIndex() int
+	// Set 将最近一次 Next 或 Previous 返回的元素替换为指定元素。
+	Set(item T)
+	// Add 在游标位置插入指定元素，之后调用 Previous 将返回该元素。
+	Add(item T)
+}
+
+// Entry 是映射中的一个键值对，对应 Java 的 java.util.Map.Entry。
+type Entry[K any, V any] struct {
+	Key   K // 键
+	Value V // 值
+}
@@ -3,64 +3,364 @@ package arraylist
 import (
 	"bytes"
 	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
+	"slices"
 )
 
+var _ collection.List[int] = (*ArrayList[int])(nil)
+
 type ArrayList[T comparable] struct {
-	size int
-	data []T
+	size           int
+	data           []T
+	modCount       int          // 结构性修改次数，用于迭代时的快速失败检测
+	growth         GrowthPolicy // 容量不足时计算新容量，为nil时使用 append 的增长策略
+	shrinkOnRemove bool         // 删除元素后是否在容量过剩时释放多余的空间
+}
+
+// GrowthPolicy 在容量不足时根据当前容量 oldCapacity 与所需的最小容量 minCapacity 计算新容量。
+// 返回值小于 minCapacity 时按 minCapacity 分配。
+type GrowthPolicy func(oldCapacity, minCapacity int) int
+
+// DoublingGrowth 每次将容量翻倍。
+func DoublingGrowth(oldCapacity, minCapacity int) int {
+	return oldCapacity * 2
+}
+
+// JavaGrowth 与 Java 的 ArrayList 相同，每次将容量增加一半。
+func JavaGrowth(oldCapacity, minCapacity int) int {
+	return oldCapacity + oldCapacity>>1
+}
+
+// FixedGrowth 返回每次将容量增加 increment 的增长策略，increment 不是正数时以 collection.ErrIllegalArgument panic。
+func FixedGrowth(increment int) GrowthPolicy {
+	if increment <= 0 {
+		panic(fmt.Errorf("%w: non-positive growth increment %d", collection.ErrIllegalArgument, increment))
+	}
+	return func(oldCapacity, minCapacity int) int {
+		return oldCapacity + increment
+	}
+}
+
+// Option 是创建 ArrayList 时的可选配置。
+type Option func(*options)
+
+type options struct {
+	growth         GrowthPolicy
+	shrinkOnRemove bool
+}
+
+// WithGrowthPolicy 指定容量不足时的增长策略，默认使用 append 的增长策略。
+func WithGrowthPolicy(policy GrowthPolicy) Option {
+	return func(o *options) {
+		o.growth = policy
+	}
+}
+
+// ShrinkOnRemove 使列表在删除元素后元素数量不足容量的四分之一时，将容量缩减为元素数量的两倍。
+func ShrinkOnRemove() Option {
+	return func(o *options) {
+		o.shrinkOnRemove = true
+	}
 }
 
 func NewArrayList[T comparable]() *ArrayList[T] {
-	return &ArrayList[T]{size: 0, data: make([]T, 0)}
+	return NewArrayListWithCapacity[T](0)
+}
+
+// 创建初始容量为 capacity 的 ArrayList，元素数量已知时可以避免扩容带来的复制；不需要预留容量时 capacity 可以为0，
+// capacity 为负数时以 collection.ErrIllegalArgument panic。
+func NewArrayListWithCapacity[T comparable](capacity int, opts ...Option) *ArrayList[T] {
+	if capacity < 0 {
+		panic(fmt.Errorf("%w: negative capacity %d", collection.ErrIllegalArgument, capacity))
+	}
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &ArrayList[T]{size: 0, data: make([]T, 0, capacity), growth: o.growth, shrinkOnRemove: o.shrinkOnRemove}
+}
+
+// 返回列表当前的容量，即不扩容时最多能容纳的元素数量。
+func (list *ArrayList[T]) Capacity() int {
+	return cap(list.data)
+}
+
+// 必要时扩容，使列表至少能容纳 minCapacity 个元素。
+func (list *ArrayList[T]) EnsureCapacity(minCapacity int) {
+	if minCapacity <= cap(list.data) {
+		return
+	}
+	if list.growth == nil {
+		list.data = slices.Grow(list.data, minCapacity-list.size)
+		return
+	}
+	list.resize(max(list.growth(cap(list.data), minCapacity), minCapacity))
+}
+
+// 将容量缩减为当前的元素数量。
+func (list *ArrayList[T]) TrimToSize() {
+	if cap(list.data) > list.size {
+		list.resize(list.size)
+	}
+}
+
+// resize 将底层数组重新分配为容量 capacity。
+func (list *ArrayList[T]) resize(capacity int) {
+	data := make([]T, list.size, capacity)
+	copy(data, list.data)
+	list.data = data
+}
+
+// shrink 在开启了 ShrinkOnRemove 且容量过剩时缩减容量。
+func (list *ArrayList[T]) shrink() {
+	if list.shrinkOnRemove && list.size < cap(list.data)/4 {
+		list.resize(list.size * 2)
+	}
 }
 
 func (list *ArrayList[T]) Add(item T) {
+	list.EnsureCapacity(list.size + 1)
 	list.data = append(list.data, item)
 	list.size++
+	list.modCount++
 }
 
 func (list *ArrayList[T]) AddAll(items ...T) {
+	list.EnsureCapacity(list.size + len(items))
 	for _, item := range items {
 		list.Add(item)
 	}
 }
 
+// 将指定元素插入到列表的指定位置，原来位于该位置及之后的元素依次后移。
+func (list *ArrayList[T]) AddAt(index int, item T) {
+	if err := collection.CheckPositionIndex(index, list.size); err != nil {
+		panic(err)
+	}
+	list.EnsureCapacity(list.size + 1)
+	list.data = slices.Insert(list.data, index, item)
+	list.size++
+	list.modCount++
+}
+
+// 将指定的所有元素按顺序插入到列表的指定位置。
+func (list *ArrayList[T]) AddAllAt(index int, items ...T) {
+	if err := collection.CheckPositionIndex(index, list.size); err != nil {
+		panic(err)
+	}
+	list.EnsureCapacity(list.size + len(items))
+	list.data = slices.Insert(list.data, index, items...)
+	list.size += len(items)
+	list.modCount++
+}
+
+// 删除位置在 [fromIndex, toIndex) 范围内的元素。
+func (list *ArrayList[T]) RemoveRange(fromIndex, toIndex int) {
+	checkRange(fromIndex, toIndex, list.size)
+	list.data = slices.Delete(list.data, fromIndex, toIndex)
+	list.size -= toIndex - fromIndex
+	list.modCount++
+	list.shrink()
+}
+
 func (list *ArrayList[T]) Get(index int) T {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
+	}
 	return list.data[index]
 }
 
 func (list *ArrayList[T]) Set(index int, item T) {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
+	}
 	list.data[index] = item
 }
 
 func (list *ArrayList[T]) Remove(index int) {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
+	}
 	copy(list.data[index:], list.data[index+1:])
+	var zero T
+	list.data[len(list.data)-1] = zero
 	list.data = list.data[:len(list.data)-1]
 	list.size--
+	list.modCount++
+	list.shrink()
 }
 
-func (list *ArrayList[T]) IndexOf(item T) int {
-	for i, v := range list.data {
-		if v == item {
-			return i
+// 与 AddAt 相同，但索引越界时返回 *collection.IndexOutOfBoundsError 而不是panic。
+func (list *ArrayList[T]) TryAddAt(index int, item T) error {
+	if err := collection.CheckPositionIndex(index, list.size); err != nil {
+		return err
+	}
+	list.AddAt(index, item)
+	return nil
+}
+
+func (list *ArrayList[T]) TryGet(index int) (T, error) {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		var zero T
+		return zero, err
+	}
+	return list.data[index], nil
+}
+
+func (list *ArrayList[T]) TrySet(index int, item T) error {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		return err
+	}
+	list.data[index] = item
+	return nil
+}
+
+func (list *ArrayList[T]) TryRemove(index int) error {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		return err
+	}
+	list.Remove(index)
+	return nil
+}
+
+// 按比较函数 cmp 对列表进行稳定排序，cmp 的约定与 slices.SortFunc 相同。
+// 使用与 Java 的 List.sort 相同的 TimSort，对部分有序的数据只需要接近线性次数的比较；
+// cmp 违反比较约定时可能以 collection.ErrIllegalArgument panic。
+func (list *ArrayList[T]) Sort(cmp func(a, b T) int) {
+	timSort(list.data[:list.size], cmp)
+	list.modCount++
+}
+
+func (list *ArrayList[T]) ContainsAll(c collection.Collection[T]) bool {
+	for item := range c.All() {
+		if !list.Contains(item) {
+			return false
 		}
 	}
-	return -1
+	return true
 }
 
-func (list *ArrayList[T]) LastIndexOf(item T) int {
-	for i := list.size - 1; i >= 0; i-- {
-		if list.data[i] == item {
-			return i
+// 删除所有满足 filter 的元素。先对每个元素调用 filter，再一次性压缩剩余元素，时间复杂度为O(n)；
+// filter 不能修改列表，否则以 collection.ErrConcurrentModification panic，此时不会删除任何元素。
+func (list *ArrayList[T]) RemoveIf(filter func(item T) bool) bool {
+	return list.removeIfRange(filter, 0, list.size) > 0
+}
+
+func (list *ArrayList[T]) RemoveAll(c collection.Collection[T]) bool {
+	return list.RemoveIf(c.Contains)
+}
+
+func (list *ArrayList[T]) RetainAll(c collection.Collection[T]) bool {
+	return list.RemoveIf(func(item T) bool { return !c.Contains(item) })
+}
+
+// removeIfRange 删除 [fromIndex, toIndex) 范围内满足 filter 的元素，返回删除的元素数量。
+func (list *ArrayList[T]) removeIfRange(filter func(item T) bool, fromIndex, toIndex int) int {
+	expectedModCount := list.modCount
+	// filter 可能修改列表，每次读取元素前都要检查，避免越界读取
+	checkForComodification := func() {
+		if list.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
 		}
 	}
-	return -1
+	i := fromIndex
+	for ; i < toIndex; i++ {
+		checkForComodification()
+		if filter(list.data[i]) {
+			break
+		}
+	}
+	var removed []bool // 从第一个被删除的元素开始，记录每个元素是否被删除
+	if i < toIndex {
+		removed = make([]bool, toIndex-i)
+		removed[0] = true
+		for j := i + 1; j < toIndex; j++ {
+			checkForComodification()
+			removed[j-i] = filter(list.data[j])
+		}
+	}
+	checkForComodification()
+	if removed == nil {
+		return 0
+	}
+	w := i
+	for j := i + 1; j < toIndex; j++ {
+		if !removed[j-i] {
+			list.data[w] = list.data[j]
+			w++
+		}
+	}
+	count := toIndex - w
+	list.data = slices.Delete(list.data, w, toIndex)
+	list.size -= count
+	list.modCount++
+	list.shrink()
+	return count
+}
+
+// 将每个元素替换为 operator 作用于该元素的结果，operator 不能修改列表。
+func (list *ArrayList[T]) ReplaceAll(operator func(item T) T) {
+	expectedModCount := list.modCount
+	for i := 0; i < list.size; i++ {
+		// operator 可能修改列表，先检查再写回，避免越界或写入已被替换的底层数组
+		item := operator(list.data[i])
+		if list.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
+		}
+		list.data[i] = item
+	}
+}
+
+// 检查 other 是否按相同顺序包含相等的元素，other 可以是任意 collection.List 实现。
+func (list *ArrayList[T]) Equals(other collection.List[T]) bool {
+	return equalItems(list.data[:list.size], other)
+}
+
+func (list *ArrayList[T]) HashCode() uint64 {
+	return hashItems(list.data[:list.size])
+}
+
+// 检查列表中是否包含指定元素，元素实现了 Equals 时按 Equals 比较，与 Equals 和 HashCode 一致。
+func (list *ArrayList[T]) Contains(item T) bool {
+	return list.IndexOf(item) >= 0
+}
+
+// 返回指定元素在列表中第一次出现的位置，不存在时返回-1。
+func (list *ArrayList[T]) IndexOf(item T) int {
+	return indexOf(list.data[:list.size], item)
+}
+
+// 返回指定元素在列表中最后一次出现的位置，不存在时返回-1。
+func (list *ArrayList[T]) LastIndexOf(item T) int {
+	return lastIndexOf(list.data[:list.size], item)
 }
 
 func (list *ArrayList[T]) Size() int {
 	return list.size
 }
 
+func (list *ArrayList[T]) IsEmpty() bool {
+	return list.size == 0
+}
+
+func (list *ArrayList[T]) Clear() {
+	var zero T
+	for i := range list.data {
+		list.data[i] = zero
+	}
+	list.data = list.data[:0]
+	list.size = 0
+	list.modCount++
+	list.shrink()
+}
+
+func (list *ArrayList[T]) ToSlice() []T {
+	slice := make([]T, list.size)
+	copy(slice, list.data)
+	return slice
+}
+
 func (list *ArrayList[T]) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("[")
@@ -73,3 +373,173 @@ func (list *ArrayList[T]) String() string {
 	buffer.WriteString("]")
 	return buffer.String()
 }
+
+func (list *ArrayList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := list.modCount
+		for i := 0; i < list.size; i++ {
+			if !yield(list.data[i]) {
+				return
+			}
+			if list.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+func (list *ArrayList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := list.modCount
+		for i := list.size - 1; i >= 0; i-- {
+			if !yield(list.data[i]) {
+				return
+			}
+			if list.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+func (list *ArrayList[T]) Iterator() collection.Iterator[T] {
+	return &iterator[T]{list: list, lastRet: -1, expectedModCount: list.modCount}
+}
+
+type iterator[T comparable] struct {
+	list             *ArrayList[T]
+	cursor           int
+	lastRet          int
+	expectedModCount int
+}
+
+func (it *iterator[T]) checkForComodification() {
+	if it.list.modCount != it.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+func (it *iterator[T]) HasNext() bool {
+	return it.cursor < it.list.size
+}
+
+func (it *iterator[T]) Next() T {
+	it.checkForComodification()
+	if !it.HasNext() {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.lastRet = it.cursor
+	it.cursor++
+	return it.list.data[it.lastRet]
+}
+
+func (it *iterator[T]) Remove() {
+	if it.lastRet < 0 {
+		panic(collection.ErrIllegalState)
+	}
+	it.checkForComodification()
+	it.list.Remove(it.lastRet)
+	it.cursor = it.lastRet
+	it.lastRet = -1
+	it.expectedModCount = it.list.modCount
+}
+
+func (list *ArrayList[T]) Spliterator() collection.Spliterator[T] {
+	return &spliterator[T]{list: list, index: 0, fence: list.size, expectedModCount: list.modCount}
+}
+
+type spliterator[T comparable] struct {
+	list             *ArrayList[T]
+	index            int
+	fence            int
+	expectedModCount int
+}
+
+func (s *spliterator[T]) TryAdvance(action func(T)) bool {
+	if s.index >= s.fence {
+		return false
+	}
+	item := s.list.data[s.index]
+	s.index++
+	action(item)
+	if s.list.modCount != s.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+	return true
+}
+
+func (s *spliterator[T]) ForEachRemaining(action func(T)) {
+	data := s.list.data
+	for ; s.index < s.fence; s.index++ {
+		action(data[s.index])
+	}
+	if s.list.modCount != s.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+func (s *spliterator[T]) TrySplit() collection.Spliterator[T] {
+	lo, mid := s.index, int(uint(s.index+s.fence)>>1)
+	if lo >= mid {
+		return nil
+	}
+	s.index = mid
+	return &spliterator[T]{list: s.list, index: lo, fence: mid, expectedModCount: s.expectedModCount}
+}
+
+func (s *spliterator[T]) EstimateSize() int {
+	return s.fence - s.index
+}
+
+func (s *spliterator[T]) Characteristics() collection.Characteristics {
+	return collection.Ordered | collection.Sized | collection.Subsized
+}
+
+// checkRange 检查 [fromIndex, toIndex) 是否是长度为 size 的列表中的合法范围，不合法时panic。
+func checkRange(fromIndex, toIndex, size int) {
+	if err := collection.CheckPositionIndex(toIndex, size); err != nil {
+		panic(err)
+	}
+	if err := collection.CheckPositionIndex(fromIndex, toIndex); err != nil {
+		panic(err)
+	}
+}
+
+// equalItems 检查 other 是否按顺序包含与 items 相等的元素。
+func equalItems[T comparable](items []T, other collection.List[T]) bool {
+	if other == nil || len(items) != other.Size() {
+		return false
+	}
+	i := 0
+	for item := range other.All() {
+		if i == len(items) || !collection.Equal(items[i], item) {
+			return false
+		}
+		i++
+	}
+	return i == len(items)
+}
+
+// hashItems 按 Java 的 List.hashCode 计算 items 的哈希码。
+func hashItems[T comparable](items []T) uint64 {
+	var hash uint64 = 1
+	for _, item := range items {
+		hash = 31*hash + collection.HashCode(item)
+	}
+	return hash
+}
+
+// indexOf 返回 item 在 items 中第一次出现的位置，按 collection.Equal 比较，不存在时返回-1。
+func indexOf[T comparable](items []T, item T) int {
+	return slices.IndexFunc(items, func(v T) bool { return collection.Equal(v, item) })
+}
+
+// lastIndexOf 返回 item 在 items 中最后一次出现的位置，按 collection.Equal 比较，不存在时返回-1。
+func lastIndexOf[T comparable](items []T, item T) int {
+	for i := len(items) - 1; i >= 0; i-- {
+		if collection.Equal(items[i], item) {
+			return i
+		}
+	}
+	return -1
+}
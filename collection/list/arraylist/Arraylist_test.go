@@ -0,0 +1,203 @@
+package arraylist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// operator 修改列表时 ReplaceAll 必须以 ErrConcurrentModification panic，而不是越界或写入已被替换的底层数组。
+func TestReplaceAllDetectsModification(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(list *ArrayList[int])
+		want   []int
+	}{
+		{"Clear", func(list *ArrayList[int]) { list.Clear() }, []int{}},
+		{"Remove", func(list *ArrayList[int]) { list.Remove(0) }, []int{-1, -2, 3}},
+		{"Add with resize", func(list *ArrayList[int]) { list.Add(100) }, []int{0, -1, -2, 3, 100}},
+	}
+	for _, tt := range tests {
+		list := NewArrayListWithCapacity[int](4)
+		list.AddAll(0, 1, 2, 3)
+		expectPanic(t, collection.ErrConcurrentModification, func() {
+			list.ReplaceAll(func(item int) int {
+				if item == 3 {
+					tt.modify(list)
+				}
+				return -item
+			})
+		})
+		// 修改前已经替换的元素保持替换后的值，触发修改的元素不会被写回
+		if got := list.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: list = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	sub := newRange(6).SubList(1, 5)
+	expectPanic(t, collection.ErrConcurrentModification, func() {
+		sub.ReplaceAll(func(item int) int {
+			sub.Clear()
+			return item
+		})
+	})
+}
+
+// 批量操作保持剩余元素的顺序，只在列表被修改时返回true。
+func TestBulkOperations(t *testing.T) {
+	of := func(items ...int) *ArrayList[int] {
+		list := NewArrayList[int]()
+		list.AddAll(items...)
+		return list
+	}
+	tests := []struct {
+		name    string
+		op      func(list *ArrayList[int]) bool
+		changed bool
+		want    []int
+	}{
+		{"RemoveIf", func(list *ArrayList[int]) bool { return list.RemoveIf(func(item int) bool { return item%2 == 0 }) }, true, []int{1, 3, 1, 5}},
+		{"RemoveIf none", func(list *ArrayList[int]) bool { return list.RemoveIf(func(item int) bool { return item > 10 }) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RemoveIf all", func(list *ArrayList[int]) bool { return list.RemoveIf(func(int) bool { return true }) }, true, []int{}},
+		{"RemoveAll", func(list *ArrayList[int]) bool { return list.RemoveAll(of(2, 5, 9)) }, true, []int{1, 3, 1, 4}},
+		{"RemoveAll disjoint", func(list *ArrayList[int]) bool { return list.RemoveAll(of(7, 8)) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RemoveAll self", func(list *ArrayList[int]) bool { return list.RemoveAll(list) }, true, []int{}},
+		{"RetainAll", func(list *ArrayList[int]) bool { return list.RetainAll(of(4, 2, 9)) }, true, []int{2, 2, 4}},
+		{"RetainAll superset", func(list *ArrayList[int]) bool { return list.RetainAll(of(1, 2, 3, 4, 5, 6)) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RetainAll empty", func(list *ArrayList[int]) bool { return list.RetainAll(of()) }, true, []int{}},
+	}
+	for _, tt := range tests {
+		list := of(1, 2, 3, 2, 1, 4, 5)
+		if changed := tt.op(list); changed != tt.changed {
+			t.Errorf("%s returned %v, want %v", tt.name, changed, tt.changed)
+		}
+		if got := list.ToSlice(); !slices.Equal(got, tt.want) || list.Size() != len(tt.want) {
+			t.Errorf("%s: list = %v (size %d), want %v", tt.name, got, list.Size(), tt.want)
+		}
+	}
+
+	list := of(1, 2, 3, 2)
+	list.ReplaceAll(func(item int) int { return item * 10 })
+	if got, want := list.ToSlice(), []int{10, 20, 30, 20}; !slices.Equal(got, want) {
+		t.Errorf("after ReplaceAll: list = %v, want %v", got, want)
+	}
+	list = of(1, 2, 3, 2)
+	if !list.ContainsAll(of(2, 3, 2)) || !list.ContainsAll(of()) || list.ContainsAll(of(1, 4)) {
+		t.Error("ContainsAll disagrees with Contains")
+	}
+	empty := of()
+	if empty.RemoveIf(func(int) bool { return true }) || empty.RetainAll(of(1)) || !empty.IsEmpty() {
+		t.Error("bulk operations on an empty list reported a change")
+	}
+}
+
+// capacities 依次添加 n 个元素，返回每次容量变化后的容量。
+func capacities(list *ArrayList[int], n int) []int {
+	var got []int
+	for i := 0; i < n; i++ {
+		list.Add(i)
+		if c := list.Capacity(); len(got) == 0 || got[len(got)-1] != c {
+			got = append(got, c)
+		}
+	}
+	return got
+}
+
+func TestGrowthPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		policy   GrowthPolicy
+		want     []int
+	}{
+		{"DoublingGrowth", 0, DoublingGrowth, []int{1, 2, 4, 8, 16, 32, 64}},
+		{"DoublingGrowth from 3", 3, DoublingGrowth, []int{3, 6, 12, 24, 48}},
+		{"JavaGrowth", 0, JavaGrowth, []int{1, 2, 3, 4, 6, 9, 13, 19, 28, 42}},
+		{"JavaGrowth from 10", 10, JavaGrowth, []int{10, 15, 22, 33, 49}},
+		{"FixedGrowth(3)", 0, FixedGrowth(3), []int{3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33, 36, 39, 42}},
+	}
+	for _, tt := range tests {
+		list := NewArrayListWithCapacity[int](tt.capacity, WithGrowthPolicy(tt.policy))
+		if got := capacities(list, 40); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: capacities = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// AddAll 一次需要的容量超过策略给出的容量时按所需容量分配
+	list := NewArrayListWithCapacity[int](2, WithGrowthPolicy(DoublingGrowth))
+	list.AddAll(make([]int, 10)...)
+	if list.Capacity() != 10 {
+		t.Errorf("capacity after AddAll of 10 = %d, want 10", list.Capacity())
+	}
+	list.EnsureCapacity(11)
+	if list.Capacity() != 20 {
+		t.Errorf("capacity after EnsureCapacity(11) = %d, want 20", list.Capacity())
+	}
+	list.EnsureCapacity(5)
+	list.TrimToSize()
+	if list.Capacity() != 10 {
+		t.Errorf("capacity after TrimToSize = %d, want 10", list.Capacity())
+	}
+}
+
+func TestShrinkOnRemove(t *testing.T) {
+	list := NewArrayListWithCapacity[int](64, ShrinkOnRemove())
+	for i := 0; i < 20; i++ {
+		list.Add(i)
+	}
+	// 元素数量不少于容量的四分之一时不缩减
+	for list.Size() > 16 {
+		list.Remove(list.Size() - 1)
+	}
+	if list.Capacity() != 64 {
+		t.Fatalf("capacity with 16 of 64 used = %d, want 64", list.Capacity())
+	}
+	// 少于四分之一时缩减为元素数量的两倍
+	list.Remove(0)
+	if list.Capacity() != 30 {
+		t.Fatalf("capacity with 15 of 64 used = %d, want 30", list.Capacity())
+	}
+	if got := list.ToSlice(); got[0] != 1 || got[len(got)-1] != 15 {
+		t.Fatalf("elements changed by shrinking: %v", got)
+	}
+
+	list.RemoveIf(func(item int) bool { return item > 7 })
+	if list.Size() != 7 || list.Capacity() != 30 {
+		t.Fatalf("after RemoveIf: size %d, capacity %d, want 7 and 30", list.Size(), list.Capacity())
+	}
+	list.RemoveIf(func(item int) bool { return item == 7 })
+	if list.Capacity() != 12 {
+		t.Fatalf("after RemoveIf: capacity %d, want 12", list.Capacity())
+	}
+	list.RemoveRange(0, 5)
+	if list.Capacity() != 2 {
+		t.Fatalf("after RemoveRange: capacity %d, want 2", list.Capacity())
+	}
+	// 容量小于4时不再缩减
+	list.Clear()
+	if list.Capacity() != 2 {
+		t.Fatalf("after Clear: capacity %d, want 2", list.Capacity())
+	}
+	list = NewArrayListWithCapacity[int](64, ShrinkOnRemove())
+	list.AddAll(1, 2, 3)
+	list.Clear()
+	if list.Capacity() != 0 {
+		t.Fatalf("after Clear: capacity %d, want 0", list.Capacity())
+	}
+
+	// 未开启时删除元素不改变容量
+	plain := NewArrayListWithCapacity[int](64)
+	plain.AddAll(1, 2, 3)
+	plain.Remove(0)
+	plain.Clear()
+	if plain.Capacity() != 64 {
+		t.Fatalf("capacity without ShrinkOnRemove = %d, want 64", plain.Capacity())
+	}
+}
+
+func TestIllegalCapacityArguments(t *testing.T) {
+	expectPanic(t, collection.ErrIllegalArgument, func() { NewArrayListWithCapacity[int](-1) })
+	expectPanic(t, collection.ErrIllegalArgument, func() { FixedGrowth(0) })
+	expectPanic(t, collection.ErrIllegalArgument, func() { FixedGrowth(-3) })
+}
@@ -0,0 +1,319 @@
+package arraylist
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
+	"slices"
+)
+
+var _ collection.List[int] = (*SubList[int])(nil)
+
+// SubList 是 ArrayList 中一段连续范围的视图，对应 Java 的 ArrayList.subList 返回的列表。
+//
+// 通过视图读写的就是父列表中的元素；通过视图增删元素会同时改变父列表以及所有外层视图的大小。
+// 视图创建后，如果父列表被视图以外的途径结构性修改，继续使用该视图会以 collection.ErrConcurrentModification panic。
+type SubList[T comparable] struct {
+	root     *ArrayList[T]
+	parent   *SubList[T] // 外层视图，直接在 ArrayList 上创建时为nil
+	offset   int         // 视图的第一个元素在 root 中的位置
+	size     int
+	modCount int // 与 root.modCount 相同时视图有效
+}
+
+// 返回位置在 [fromIndex, toIndex) 范围内的元素组成的视图，fromIndex 等于 toIndex 时视图为空。
+func (list *ArrayList[T]) SubList(fromIndex, toIndex int) *SubList[T] {
+	checkRange(fromIndex, toIndex, list.size)
+	return &SubList[T]{root: list, offset: fromIndex, size: toIndex - fromIndex, modCount: list.modCount}
+}
+
+// 返回本视图中 [fromIndex, toIndex) 范围的视图，对它的结构性修改同样会反映到本视图。
+func (sub *SubList[T]) SubList(fromIndex, toIndex int) *SubList[T] {
+	sub.checkForComodification()
+	checkRange(fromIndex, toIndex, sub.size)
+	return &SubList[T]{root: sub.root, parent: sub, offset: sub.offset + fromIndex, size: toIndex - fromIndex, modCount: sub.modCount}
+}
+
+func (sub *SubList[T]) checkForComodification() {
+	if sub.root.modCount != sub.modCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+// updateSizeAndModCount 在通过视图进行结构性修改后，同步本视图及所有外层视图的大小与修改次数。
+func (sub *SubList[T]) updateSizeAndModCount(delta int) {
+	for s := sub; s != nil; s = s.parent {
+		s.size += delta
+		s.modCount = s.root.modCount
+	}
+}
+
+// items 返回视图范围内的底层切片，调用方不能改变它的长度。
+func (sub *SubList[T]) items() []T {
+	sub.checkForComodification()
+	return sub.root.data[sub.offset : sub.offset+sub.size]
+}
+
+func (sub *SubList[T]) Add(item T) {
+	sub.AddAt(sub.Size(), item)
+}
+
+func (sub *SubList[T]) AddAll(items ...T) {
+	sub.AddAllAt(sub.Size(), items...)
+}
+
+func (sub *SubList[T]) AddAt(index int, item T) {
+	sub.checkForComodification()
+	if err := collection.CheckPositionIndex(index, sub.size); err != nil {
+		panic(err)
+	}
+	sub.root.AddAt(sub.offset+index, item)
+	sub.updateSizeAndModCount(1)
+}
+
+func (sub *SubList[T]) AddAllAt(index int, items ...T) {
+	sub.checkForComodification()
+	if err := collection.CheckPositionIndex(index, sub.size); err != nil {
+		panic(err)
+	}
+	sub.root.AddAllAt(sub.offset+index, items...)
+	sub.updateSizeAndModCount(len(items))
+}
+
+func (sub *SubList[T]) Get(index int) T {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		panic(err)
+	}
+	return sub.root.data[sub.offset+index]
+}
+
+func (sub *SubList[T]) Set(index int, item T) {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		panic(err)
+	}
+	sub.root.data[sub.offset+index] = item
+}
+
+func (sub *SubList[T]) Remove(index int) {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		panic(err)
+	}
+	sub.root.Remove(sub.offset + index)
+	sub.updateSizeAndModCount(-1)
+}
+
+func (sub *SubList[T]) RemoveRange(fromIndex, toIndex int) {
+	sub.checkForComodification()
+	checkRange(fromIndex, toIndex, sub.size)
+	sub.root.RemoveRange(sub.offset+fromIndex, sub.offset+toIndex)
+	sub.updateSizeAndModCount(fromIndex - toIndex)
+}
+
+func (sub *SubList[T]) TryAddAt(index int, item T) error {
+	sub.checkForComodification()
+	if err := collection.CheckPositionIndex(index, sub.size); err != nil {
+		return err
+	}
+	sub.AddAt(index, item)
+	return nil
+}
+
+func (sub *SubList[T]) TryGet(index int) (T, error) {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		var zero T
+		return zero, err
+	}
+	return sub.root.data[sub.offset+index], nil
+}
+
+func (sub *SubList[T]) TrySet(index int, item T) error {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		return err
+	}
+	sub.root.data[sub.offset+index] = item
+	return nil
+}
+
+func (sub *SubList[T]) TryRemove(index int) error {
+	sub.checkForComodification()
+	if err := collection.CheckIndex(index, sub.size); err != nil {
+		return err
+	}
+	sub.Remove(index)
+	return nil
+}
+
+// 对视图范围内的元素进行稳定排序，规则与 ArrayList.Sort 相同；排序不改变元素数量，因此不会使其他视图失效。
+func (sub *SubList[T]) Sort(cmp func(a, b T) int) {
+	timSort(sub.items(), cmp)
+}
+
+func (sub *SubList[T]) Equals(other collection.List[T]) bool {
+	return equalItems(sub.items(), other)
+}
+
+func (sub *SubList[T]) HashCode() uint64 {
+	return hashItems(sub.items())
+}
+
+func (sub *SubList[T]) Contains(item T) bool {
+	return sub.IndexOf(item) >= 0
+}
+
+func (sub *SubList[T]) ContainsAll(c collection.Collection[T]) bool {
+	for item := range c.All() {
+		if !sub.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// 删除视图中所有满足 filter 的元素，规则与 ArrayList.RemoveIf 相同。
+func (sub *SubList[T]) RemoveIf(filter func(item T) bool) bool {
+	sub.checkForComodification()
+	removed := sub.root.removeIfRange(filter, sub.offset, sub.offset+sub.size)
+	if removed == 0 {
+		return false
+	}
+	sub.updateSizeAndModCount(-removed)
+	return true
+}
+
+func (sub *SubList[T]) RemoveAll(c collection.Collection[T]) bool {
+	return sub.RemoveIf(c.Contains)
+}
+
+func (sub *SubList[T]) RetainAll(c collection.Collection[T]) bool {
+	return sub.RemoveIf(func(item T) bool { return !c.Contains(item) })
+}
+
+func (sub *SubList[T]) ReplaceAll(operator func(item T) T) {
+	items := sub.items()
+	expectedModCount := sub.modCount
+	for i := range items {
+		// operator 也可能通过本视图修改列表，因此与进入时的修改次数比较
+		item := operator(items[i])
+		if sub.root.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
+		}
+		items[i] = item
+	}
+}
+
+func (sub *SubList[T]) IndexOf(item T) int {
+	return indexOf(sub.items(), item)
+}
+
+func (sub *SubList[T]) LastIndexOf(item T) int {
+	return lastIndexOf(sub.items(), item)
+}
+
+func (sub *SubList[T]) Size() int {
+	sub.checkForComodification()
+	return sub.size
+}
+
+func (sub *SubList[T]) IsEmpty() bool {
+	return sub.Size() == 0
+}
+
+// 删除视图中的所有元素，即删除父列表中对应范围的元素。
+func (sub *SubList[T]) Clear() {
+	sub.RemoveRange(0, sub.Size())
+}
+
+func (sub *SubList[T]) ToSlice() []T {
+	return slices.Clone(sub.items())
+}
+
+func (sub *SubList[T]) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("[")
+	for i, v := range sub.items() {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString(fmt.Sprintf("%v", v))
+	}
+	buffer.WriteString("]")
+	return buffer.String()
+}
+
+func (sub *SubList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sub.checkForComodification()
+		for i := 0; i < sub.size; i++ {
+			if !yield(sub.root.data[sub.offset+i]) {
+				return
+			}
+			sub.checkForComodification()
+		}
+	}
+}
+
+func (sub *SubList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sub.checkForComodification()
+		for i := sub.size - 1; i >= 0; i-- {
+			if !yield(sub.root.data[sub.offset+i]) {
+				return
+			}
+			sub.checkForComodification()
+		}
+	}
+}
+
+func (sub *SubList[T]) Iterator() collection.Iterator[T] {
+	sub.checkForComodification()
+	return &subListIterator[T]{sub: sub, lastRet: -1, expectedModCount: sub.modCount}
+}
+
+type subListIterator[T comparable] struct {
+	sub              *SubList[T]
+	cursor           int
+	lastRet          int
+	expectedModCount int
+}
+
+func (it *subListIterator[T]) checkForComodification() {
+	if it.sub.root.modCount != it.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+func (it *subListIterator[T]) HasNext() bool {
+	return it.cursor < it.sub.size
+}
+
+func (it *subListIterator[T]) Next() T {
+	it.checkForComodification()
+	if !it.HasNext() {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.lastRet = it.cursor
+	it.cursor++
+	return it.sub.root.data[it.sub.offset+it.lastRet]
+}
+
+func (it *subListIterator[T]) Remove() {
+	if it.lastRet < 0 {
+		panic(collection.ErrIllegalState)
+	}
+	it.checkForComodification()
+	it.sub.Remove(it.lastRet)
+	it.cursor = it.lastRet
+	it.lastRet = -1
+	it.expectedModCount = it.sub.root.modCount
+}
+
+func (sub *SubList[T]) Spliterator() collection.Spliterator[T] {
+	sub.checkForComodification()
+	return &spliterator[T]{list: sub.root, index: sub.offset, fence: sub.offset + sub.size, expectedModCount: sub.modCount}
+}
@@ -0,0 +1,146 @@
+package arraylist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// newRange 返回包含 0 到 n-1 的列表。
+func newRange(n int) *ArrayList[int] {
+	list := NewArrayList[int]()
+	for i := 0; i < n; i++ {
+		list.Add(i)
+	}
+	return list
+}
+
+// expectPanic 检查 f 以包装了 target 的错误panic。
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, target) {
+			t.Fatalf("panic = %v, want %v", err, target)
+		}
+	}()
+	f()
+}
+
+func TestSubListWritesThrough(t *testing.T) {
+	list := newRange(10)
+	sub := list.SubList(2, 6)
+
+	sub.Set(0, 20)
+	sub.AddAt(1, 21)
+	sub.Remove(4)
+	sub.Add(22)
+
+	if got, want := sub.ToSlice(), []int{20, 21, 3, 4, 22}; !slices.Equal(got, want) {
+		t.Fatalf("sub = %v, want %v", got, want)
+	}
+	if got, want := list.ToSlice(), []int{0, 1, 20, 21, 3, 4, 22, 6, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Fatalf("list = %v, want %v", got, want)
+	}
+
+	sub.Clear()
+	if !sub.IsEmpty() || list.Size() != 6 {
+		t.Fatalf("after Clear: sub size %d, list %v", sub.Size(), list.ToSlice())
+	}
+}
+
+func TestNestedSubListsPropagateChanges(t *testing.T) {
+	list := newRange(20)
+	outer := list.SubList(2, 18)   // 2..17
+	middle := outer.SubList(3, 13) // 5..14
+	inner := middle.SubList(2, 6)  // 7..10
+
+	inner.AddAllAt(1, 100, 101)
+	inner.RemoveIf(func(item int) bool { return item%2 == 0 })
+
+	if got, want := inner.ToSlice(), []int{7, 101, 9}; !slices.Equal(got, want) {
+		t.Fatalf("inner = %v, want %v", got, want)
+	}
+	// 每一层视图的大小都随内层的修改而变化，外层视图仍然可用
+	if middle.Size() != 9 || outer.Size() != 15 || list.Size() != 19 {
+		t.Fatalf("sizes = %d, %d, %d, want 9, 15, 19", middle.Size(), outer.Size(), list.Size())
+	}
+	if got, want := middle.ToSlice(), []int{5, 6, 7, 101, 9, 11, 12, 13, 14}; !slices.Equal(got, want) {
+		t.Fatalf("middle = %v, want %v", got, want)
+	}
+	if middle.IndexOf(101) != 3 || outer.IndexOf(101) != 6 || list.IndexOf(101) != 8 {
+		t.Fatal("IndexOf disagrees between nested views")
+	}
+
+	inner.Sort(func(a, b int) int { return b - a })
+	if got, want := list.ToSlice()[7:10], []int{101, 9, 7}; !slices.Equal(got, want) {
+		t.Fatalf("list after inner.Sort = %v, want %v", got, want)
+	}
+}
+
+func TestSubListDetectsComodification(t *testing.T) {
+	list := newRange(10)
+	sub := list.SubList(2, 8)
+	nested := sub.SubList(1, 3)
+
+	// 通过父视图的修改使子视图失效，父视图本身仍然可用
+	sub.Add(100)
+	if sub.Size() != 7 {
+		t.Fatalf("sub size = %d, want 7", sub.Size())
+	}
+	expectPanic(t, collection.ErrConcurrentModification, func() { nested.Get(0) })
+
+	// 直接修改底层列表使所有视图失效
+	list.Add(200)
+	expectPanic(t, collection.ErrConcurrentModification, func() { sub.Size() })
+	expectPanic(t, collection.ErrConcurrentModification, func() { sub.Add(1) })
+}
+
+func TestSubListIteratorsFailFast(t *testing.T) {
+	list := newRange(10)
+	sub := list.SubList(2, 8)
+
+	it := sub.Iterator()
+	it.Next()
+	list.Set(0, -1) // Set 不是结构性修改
+	it.Next()
+	list.Add(10)
+	expectPanic(t, collection.ErrConcurrentModification, func() { it.Next() })
+
+	sub = list.SubList(2, 8)
+	expectPanic(t, collection.ErrConcurrentModification, func() {
+		for range sub.All() {
+			list.Remove(0)
+		}
+	})
+
+	// 上面的遍历在 panic 前删除了 -1；迭代器自己的 Remove 不会使它失效
+	sub = list.SubList(0, 6)
+	for it := sub.Iterator(); it.HasNext(); {
+		if it.Next()%2 == 1 {
+			it.Remove()
+		}
+	}
+	if got, want := sub.ToSlice(), []int{2, 4, 6}; !slices.Equal(got, want) {
+		t.Fatalf("sub = %v, want %v", got, want)
+	}
+}
+
+func TestSubListBounds(t *testing.T) {
+	list := newRange(5)
+	expectPanic(t, collection.ErrIndexOutOfBounds, func() { list.SubList(-1, 2) })
+	expectPanic(t, collection.ErrIndexOutOfBounds, func() { list.SubList(0, 6) })
+
+	sub := list.SubList(1, 4)
+	expectPanic(t, collection.ErrIndexOutOfBounds, func() { sub.Get(3) })
+	expectPanic(t, collection.ErrIndexOutOfBounds, func() { sub.SubList(2, 4) })
+	if _, err := sub.TryGet(3); !errors.Is(err, collection.ErrIndexOutOfBounds) {
+		t.Fatalf("TryGet(3) error = %v", err)
+	}
+	if empty := sub.SubList(3, 3); !empty.IsEmpty() {
+		t.Fatal("SubList(3, 3) is not empty")
+	}
+}
@@ -0,0 +1,453 @@
+package arraylist
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"slices"
+)
+
+// 本文件是 Java 的 java.util.TimSort 的移植：先找出数据中已有的升序段（降序段会被翻转），
+// 不足 minRun 的段用二分插入排序补齐，再按栈上各段长度的不变式合并；合并时一侧连续胜出 minGallop 次后
+// 切换为“飞奔”模式，以指数搜索批量复制元素。排序是稳定的，对部分有序的数据只需要接近 O(n) 次比较。
+
+const (
+	minMerge  = 32 // 短于该长度的切片直接使用二分插入排序
+	minGallop = 7  // 进入飞奔模式的初始阈值
+)
+
+// errComparisonContract 表示合并时发现 cmp 违反了比较约定，对应 Java 抛出的 IllegalArgumentException。
+var errComparisonContract = fmt.Errorf("%w: comparison method violates its general contract", collection.ErrIllegalArgument)
+
+// timSorter 保存一次排序过程中的状态。
+type timSorter[T any] struct {
+	a         []T
+	cmp       func(a, b T) int
+	minGallop int
+	tmp       []T   // 合并时使用的临时空间
+	runBase   []int // 待合并段的起始位置
+	runLen    []int // 待合并段的长度
+}
+
+// timSort 按 cmp 对 a 进行稳定排序，cmp 违反比较约定时可能以 collection.ErrIllegalArgument panic。
+func timSort[T any](a []T, cmp func(a, b T) int) {
+	lo, hi := 0, len(a)
+	remaining := hi - lo
+	if remaining < 2 {
+		return
+	}
+	if remaining < minMerge {
+		initRunLen := countRunAndMakeAscending(a, lo, hi, cmp)
+		binarySort(a, lo, hi, lo+initRunLen, cmp)
+		return
+	}
+
+	ts := &timSorter[T]{a: a, cmp: cmp, minGallop: minGallop}
+	minRun := minRunLength(remaining)
+	for remaining != 0 {
+		runLen := countRunAndMakeAscending(a, lo, hi, cmp)
+		if runLen < minRun {
+			force := min(remaining, minRun)
+			binarySort(a, lo, lo+force, lo+runLen, cmp)
+			runLen = force
+		}
+		ts.runBase = append(ts.runBase, lo)
+		ts.runLen = append(ts.runLen, runLen)
+		ts.mergeCollapse()
+		lo += runLen
+		remaining -= runLen
+	}
+	ts.mergeForceCollapse()
+}
+
+// binarySort 用二分插入排序对 a[lo:hi] 排序，调用前 a[lo:start] 已经有序。
+func binarySort[T any](a []T, lo, hi, start int, cmp func(a, b T) int) {
+	if start == lo {
+		start++
+	}
+	for ; start < hi; start++ {
+		pivot := a[start]
+		left, right := lo, start
+		for left < right {
+			mid := int(uint(left+right) >> 1)
+			if cmp(pivot, a[mid]) < 0 {
+				right = mid
+			} else {
+				left = mid + 1
+			}
+		}
+		copy(a[left+1:start+1], a[left:start])
+		a[left] = pivot
+	}
+}
+
+// countRunAndMakeAscending 返回从 lo 开始的最长升序段或严格降序段的长度，降序段会被原地翻转。
+// 只翻转严格降序的段，以保证排序的稳定性。
+func countRunAndMakeAscending[T any](a []T, lo, hi int, cmp func(a, b T) int) int {
+	runHi := lo + 1
+	if runHi == hi {
+		return 1
+	}
+	if cmp(a[runHi], a[lo]) < 0 {
+		runHi++
+		for runHi < hi && cmp(a[runHi], a[runHi-1]) < 0 {
+			runHi++
+		}
+		slices.Reverse(a[lo:runHi])
+	} else {
+		runHi++
+		for runHi < hi && cmp(a[runHi], a[runHi-1]) >= 0 {
+			runHi++
+		}
+	}
+	return runHi - lo
+}
+
+// minRunLength 返回长度为 n 的数据的最小段长度，使 n/minRun 接近且不超过2的幂。
+func minRunLength(n int) int {
+	r := 0
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// mergeCollapse 合并栈顶的段，直到满足 runLen[i-2] > runLen[i-1] + runLen[i] 且 runLen[i-1] > runLen[i]。
+func (ts *timSorter[T]) mergeCollapse() {
+	for len(ts.runLen) > 1 {
+		n := len(ts.runLen) - 2
+		if n > 0 && ts.runLen[n-1] <= ts.runLen[n]+ts.runLen[n+1] || n > 1 && ts.runLen[n-2] <= ts.runLen[n]+ts.runLen[n-1] {
+			if ts.runLen[n-1] < ts.runLen[n+1] {
+				n--
+			}
+		} else if ts.runLen[n] > ts.runLen[n+1] {
+			break
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse 合并栈上剩余的所有段。
+func (ts *timSorter[T]) mergeForceCollapse() {
+	for len(ts.runLen) > 1 {
+		n := len(ts.runLen) - 2
+		if n > 0 && ts.runLen[n-1] < ts.runLen[n+1] {
+			n--
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeAt 合并栈上第 i 与第 i+1 个段。
+func (ts *timSorter[T]) mergeAt(i int) {
+	a, cmp := ts.a, ts.cmp
+	base1, len1 := ts.runBase[i], ts.runLen[i]
+	base2, len2 := ts.runBase[i+1], ts.runLen[i+1]
+	ts.runLen[i] = len1 + len2
+	ts.runBase = slices.Delete(ts.runBase, i+1, i+2)
+	ts.runLen = slices.Delete(ts.runLen, i+1, i+2)
+
+	// 第一段中不大于第二段首元素的前缀已经就位
+	k := gallopRight(a[base2], a, base1, len1, 0, cmp)
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+	// 第二段中不小于第一段末元素的后缀已经就位
+	len2 = gallopLeft(a[base1+len1-1], a, base2, len2, len2-1, cmp)
+	if len2 == 0 {
+		return
+	}
+	if len1 <= len2 {
+		ts.mergeLo(base1, len1, base2, len2)
+	} else {
+		ts.mergeHi(base1, len1, base2, len2)
+	}
+}
+
+// gallopLeft 在有序的 a[base:base+length] 中从 hint 处开始指数搜索，返回 key 应插入的最左位置（相对 base）。
+func gallopLeft[T any](key T, a []T, base, length, hint int, cmp func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+	if cmp(key, a[base+hint]) > 0 {
+		maxOfs := length - hint
+		for ofs < maxOfs && cmp(key, a[base+hint+ofs]) > 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	} else {
+		maxOfs := hint + 1
+		for ofs < maxOfs && cmp(key, a[base+hint-ofs]) <= 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+	lastOfs++
+	for lastOfs < ofs {
+		m := lastOfs + (ofs-lastOfs)>>1
+		if cmp(key, a[base+m]) > 0 {
+			lastOfs = m + 1
+		} else {
+			ofs = m
+		}
+	}
+	return ofs
+}
+
+// gallopRight 与 gallopLeft 相同，但存在与 key 相等的元素时返回最右的插入位置。
+func gallopRight[T any](key T, a []T, base, length, hint int, cmp func(a, b T) int) int {
+	lastOfs, ofs := 0, 1
+	if cmp(key, a[base+hint]) < 0 {
+		maxOfs := hint + 1
+		for ofs < maxOfs && cmp(key, a[base+hint-ofs]) < 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	} else {
+		maxOfs := length - hint
+		for ofs < maxOfs && cmp(key, a[base+hint+ofs]) >= 0 {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	}
+	lastOfs++
+	for lastOfs < ofs {
+		m := lastOfs + (ofs-lastOfs)>>1
+		if cmp(key, a[base+m]) < 0 {
+			ofs = m
+		} else {
+			lastOfs = m + 1
+		}
+	}
+	return ofs
+}
+
+// mergeLo 从前向后合并两个相邻的段，要求 len1 <= len2，第一段被复制到临时空间。
+// 调用前第一段的首元素大于第二段的首元素，第一段的末元素大于第二段的所有元素。
+func (ts *timSorter[T]) mergeLo(base1, len1, base2, len2 int) {
+	a, cmp := ts.a, ts.cmp
+	tmp := ts.ensureCapacity(len1)
+	copy(tmp, a[base1:base1+len1])
+	cursor1, cursor2, dest := 0, base2, base1
+
+	a[dest] = a[cursor2]
+	dest++
+	cursor2++
+	if len2--; len2 == 0 {
+		copy(a[dest:dest+len1], tmp[cursor1:cursor1+len1])
+		return
+	}
+	if len1 == 1 {
+		copy(a[dest:dest+len2], a[cursor2:cursor2+len2])
+		a[dest+len2] = tmp[cursor1]
+		return
+	}
+
+	gallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0 // 两侧连续胜出的次数
+		for {
+			if cmp(a[cursor2], tmp[cursor1]) < 0 {
+				a[dest] = a[cursor2]
+				dest++
+				cursor2++
+				count2++
+				count1 = 0
+				if len2--; len2 == 0 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[cursor1]
+				dest++
+				cursor1++
+				count1++
+				count2 = 0
+				if len1--; len1 == 1 {
+					break outer
+				}
+			}
+			if count1|count2 >= gallop {
+				break
+			}
+		}
+
+		// 一侧连续胜出，切换到飞奔模式，直到两侧都不再成批胜出
+		for {
+			count1 = gallopRight(a[cursor2], tmp, cursor1, len1, 0, cmp)
+			if count1 != 0 {
+				copy(a[dest:dest+count1], tmp[cursor1:cursor1+count1])
+				dest += count1
+				cursor1 += count1
+				len1 -= count1
+				if len1 <= 1 {
+					break outer
+				}
+			}
+			a[dest] = a[cursor2]
+			dest++
+			cursor2++
+			if len2--; len2 == 0 {
+				break outer
+			}
+
+			count2 = gallopLeft(tmp[cursor1], a, cursor2, len2, 0, cmp)
+			if count2 != 0 {
+				copy(a[dest:dest+count2], a[cursor2:cursor2+count2])
+				dest += count2
+				cursor2 += count2
+				len2 -= count2
+				if len2 == 0 {
+					break outer
+				}
+			}
+			a[dest] = tmp[cursor1]
+			dest++
+			cursor1++
+			if len1--; len1 == 1 {
+				break outer
+			}
+			gallop--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		gallop = max(gallop, 0) + 2 // 离开飞奔模式的惩罚
+	}
+	ts.minGallop = max(gallop, 1)
+
+	switch {
+	case len1 == 1:
+		copy(a[dest:dest+len2], a[cursor2:cursor2+len2])
+		a[dest+len2] = tmp[cursor1]
+	case len1 == 0:
+		panic(errComparisonContract)
+	default:
+		copy(a[dest:dest+len1], tmp[cursor1:cursor1+len1])
+	}
+}
+
+// mergeHi 与 mergeLo 相同，但从后向前合并，要求 len1 >= len2，第二段被复制到临时空间。
+func (ts *timSorter[T]) mergeHi(base1, len1, base2, len2 int) {
+	a, cmp := ts.a, ts.cmp
+	tmp := ts.ensureCapacity(len2)
+	copy(tmp, a[base2:base2+len2])
+	cursor1, cursor2, dest := base1+len1-1, len2-1, base2+len2-1
+
+	a[dest] = a[cursor1]
+	dest--
+	cursor1--
+	if len1--; len1 == 0 {
+		copy(a[dest-(len2-1):dest+1], tmp[:len2])
+		return
+	}
+	if len2 == 1 {
+		dest -= len1
+		cursor1 -= len1
+		copy(a[dest+1:dest+1+len1], a[cursor1+1:cursor1+1+len1])
+		a[dest] = tmp[cursor2]
+		return
+	}
+
+	gallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0
+		for {
+			if cmp(tmp[cursor2], a[cursor1]) < 0 {
+				a[dest] = a[cursor1]
+				dest--
+				cursor1--
+				count1++
+				count2 = 0
+				if len1--; len1 == 0 {
+					break outer
+				}
+			} else {
+				a[dest] = tmp[cursor2]
+				dest--
+				cursor2--
+				count2++
+				count1 = 0
+				if len2--; len2 == 1 {
+					break outer
+				}
+			}
+			if count1|count2 >= gallop {
+				break
+			}
+		}
+
+		for {
+			count1 = len1 - gallopRight(tmp[cursor2], a, base1, len1, len1-1, cmp)
+			if count1 != 0 {
+				dest -= count1
+				cursor1 -= count1
+				len1 -= count1
+				copy(a[dest+1:dest+1+count1], a[cursor1+1:cursor1+1+count1])
+				if len1 == 0 {
+					break outer
+				}
+			}
+			a[dest] = tmp[cursor2]
+			dest--
+			cursor2--
+			if len2--; len2 == 1 {
+				break outer
+			}
+
+			count2 = len2 - gallopLeft(a[cursor1], tmp, 0, len2, len2-1, cmp)
+			if count2 != 0 {
+				dest -= count2
+				cursor2 -= count2
+				len2 -= count2
+				copy(a[dest+1:dest+1+count2], tmp[cursor2+1:cursor2+1+count2])
+				if len2 <= 1 {
+					break outer
+				}
+			}
+			a[dest] = a[cursor1]
+			dest--
+			cursor1--
+			if len1--; len1 == 0 {
+				break outer
+			}
+			gallop--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		gallop = max(gallop, 0) + 2
+	}
+	ts.minGallop = max(gallop, 1)
+
+	switch {
+	case len2 == 1:
+		dest -= len1
+		cursor1 -= len1
+		copy(a[dest+1:dest+1+len1], a[cursor1+1:cursor1+1+len1])
+		a[dest] = tmp[cursor2]
+	case len2 == 0:
+		panic(errComparisonContract)
+	default:
+		copy(a[dest-(len2-1):dest+1], tmp[:len2])
+	}
+}
+
+// ensureCapacity 返回长度至少为 n 的临时空间。
+func (ts *timSorter[T]) ensureCapacity(n int) []T {
+	if len(ts.tmp) < n {
+		ts.tmp = make([]T, max(n, min(2*len(ts.tmp), len(ts.a)/2)))
+	}
+	return ts.tmp
+}
@@ -0,0 +1,125 @@
+package arraylist
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// item 按 key 排序，seq 记录原始位置，用于检查排序的稳定性。
+type item struct {
+	key, seq int
+}
+
+func compareItems(a, b item) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// sortSizes 覆盖空切片、二分插入排序、minMerge 附近的边界以及需要多次合并的长度。
+var sortSizes = []int{0, 1, 2, minGallop - 1, minGallop, minGallop + 1, minMerge - 1, minMerge, minMerge + 1,
+	2*minMerge - 1, 2 * minMerge, 2*minMerge + 1, 100, 1000, 5000}
+
+// sortInputs 返回长度为 n 的各种分布的键，包括长度在 minGallop 与 minMerge 附近的升序和降序段。
+func sortInputs(n int) map[string][]int {
+	rnd := rand.New(rand.NewPCG(uint64(n), 1))
+	inputs := map[string][]int{}
+	generate := func(name string, key func(i int) int) {
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = key(i)
+		}
+		inputs[name] = keys
+	}
+	generate("random", func(int) int { return rnd.IntN(n/4 + 1) })
+	generate("few distinct", func(int) int { return rnd.IntN(3) })
+	generate("ascending", func(i int) int { return i / 3 })
+	generate("descending", func(i int) int { return (n - i) / 3 })
+	for _, run := range []int{minGallop - 1, minGallop, minGallop + 1, minMerge - 1, minMerge, minMerge + 1} {
+		// 交替出现的升序段与降序段，段内有重复的键
+		generate(fmt.Sprintf("runs of %d", run), func(i int) int {
+			block, offset := i/run, i%run
+			if block%2 == 0 {
+				return block*run/2 + offset/2
+			}
+			return block*run/2 + (run-offset)/2
+		})
+		// 两个有序序列按 run 个元素交错排列，合并时一侧会连续胜出 run 次
+		generate(fmt.Sprintf("gallop %d", run), func(i int) int {
+			if i < n/2 {
+				return (i/run)*2*run + i%run
+			}
+			j := i - n/2
+			return (j/run)*2*run + run + j%run
+		})
+	}
+	return inputs
+}
+
+// checkSorted 检查 got 与 slices.SortStableFunc 对同一输入的结果完全一致，即有序且相等的键保持原始顺序。
+func checkSorted(t *testing.T, name string, keys []int, sort func([]item) []item) {
+	t.Helper()
+	input := make([]item, len(keys))
+	for i, key := range keys {
+		input[i] = item{key, i}
+	}
+	want := slices.Clone(input)
+	slices.SortStableFunc(want, compareItems)
+	got := sort(slices.Clone(input))
+	if !slices.Equal(got, want) {
+		i := 0
+		for i < len(got) && i < len(want) && got[i] == want[i] {
+			i++
+		}
+		t.Fatalf("%s (n=%d): result differs from slices.SortStableFunc at index %d", name, len(keys), i)
+	}
+}
+
+func TestTimSortMatchesStableSort(t *testing.T) {
+	for _, n := range sortSizes {
+		for name, keys := range sortInputs(n) {
+			checkSorted(t, name, keys, func(items []item) []item {
+				timSort(items, compareItems)
+				return items
+			})
+		}
+	}
+}
+
+func TestArrayListSortMatchesStableSort(t *testing.T) {
+	for _, n := range sortSizes {
+		for name, keys := range sortInputs(n) {
+			checkSorted(t, name, keys, func(items []item) []item {
+				list := NewArrayListWithCapacity[item](len(items))
+				list.AddAll(items...)
+				list.Sort(compareItems)
+				return list.ToSlice()
+			})
+		}
+	}
+}
+
+// 违反约定的比较函数不能让排序死循环或越界，只允许正常结束或以约定的错误panic。
+func TestTimSortInconsistentComparator(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(7, 7))
+	items := make([]int, 10000)
+	for i := range items {
+		items[i] = i
+	}
+	for round := 0; round < 20; round++ {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					if err, ok := r.(error); !ok || !errors.Is(err, collection.ErrIllegalArgument) {
+						t.Fatalf("unexpected panic: %v", r)
+					}
+				}
+			}()
+			timSort(slices.Clone(items), func(a, b int) int { return rnd.IntN(3) - 1 })
+		}()
+	}
+}
@@ -3,16 +3,21 @@ package linkedlist
 import (
 	"bytes"
 	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
 )
 
+var _ collection.List[int] = (*LinkedList[int])(nil)
+
 type Node[T comparable] struct {
 	value T        // 节点的值
 	next  *Node[T] // 下一个节点的指针
 }
 
 type LinkedList[T comparable] struct {
-	head *Node[T] // 链表头部节点
-	size int      // 链表大小
+	head     *Node[T] // 链表头部节点
+	size     int      // 链表大小
+	modCount int      // 结构性修改次数，用于迭代时的快速失败检测
 }
 
 type List[T comparable] struct {
@@ -39,12 +44,20 @@ func (list *LinkedList[T]) Add(item T) {
 		current.next = node
 	}
 	list.size++
+	list.modCount++
+}
+
+// 将指定的所有元素依次添加到列表末尾。
+func (list *LinkedList[T]) AddAll(items ...T) {
+	for _, item := range items {
+		list.Add(item)
+	}
 }
 
 // 将指定元素插入到列表的指定位置。
 func (list *LinkedList[T]) AddAt(index int, item T) {
-	if index < 0 || index > list.size {
-		panic("index out of bounds")
+	if err := collection.CheckPositionIndex(index, list.size); err != nil {
+		panic(err)
 	}
 	if index == 0 {
 		list.head = &Node[T]{value: item, next: list.head}
@@ -53,29 +66,30 @@ func (list *LinkedList[T]) AddAt(index int, item T) {
 		prev.next = &Node[T]{value: item, next: prev.next}
 	}
 	list.size++
+	list.modCount++
 }
 
 // 返回列表中指定位置的元素。
 func (list *LinkedList[T]) Get(index int) T {
-	if index < 0 || index >= list.size {
-		panic("index out of bounds")
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
 	}
 	return list.getNode(index).value
 }
 
 // 将列表中指定位置的元素替换为指定元素。
 func (list *LinkedList[T]) Set(index int, item T) {
-	if index < 0 || index >= list.size {
-		panic("index out of bounds")
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
 	}
 	node := list.getNode(index)
 	node.value = item
 }
 
 // 删除列表中指定位置的元素。
-func (list *LinkedList[T]) Remove(index int) bool {
-	if index < 0 || index >= list.size {
-		panic("index out of bounds")
+func (list *LinkedList[T]) Remove(index int) {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		panic(err)
 	}
 	if index == 0 {
 		list.head = list.head.next
@@ -84,7 +98,43 @@ func (list *LinkedList[T]) Remove(index int) bool {
 		prev.next = prev.next.next
 	}
 	list.size--
-	return true
+	list.modCount++
+}
+
+// 与 AddAt 相同，但索引越界时返回 *collection.IndexOutOfBoundsError 而不是panic。
+func (list *LinkedList[T]) TryAddAt(index int, item T) error {
+	if err := collection.CheckPositionIndex(index, list.size); err != nil {
+		return err
+	}
+	list.AddAt(index, item)
+	return nil
+}
+
+// 与 Get 相同，但索引越界时返回 *collection.IndexOutOfBoundsError 而不是panic。
+func (list *LinkedList[T]) TryGet(index int) (T, error) {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		var zero T
+		return zero, err
+	}
+	return list.getNode(index).value, nil
+}
+
+// 与 Set 相同，但索引越界时返回 *collection.IndexOutOfBoundsError 而不是panic。
+func (list *LinkedList[T]) TrySet(index int, item T) error {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		return err
+	}
+	list.Set(index, item)
+	return nil
+}
+
+// 与 Remove 相同，但索引越界时返回 *collection.IndexOutOfBoundsError 而不是panic。
+func (list *LinkedList[T]) TryRemove(index int) error {
+	if err := collection.CheckIndex(index, list.size); err != nil {
+		return err
+	}
+	list.Remove(index)
+	return nil
 }
 
 // 返回列表中的元素数量。
@@ -97,6 +147,194 @@ func (list *LinkedList[T]) IsEmpty() bool {
 	return list.size == 0
 }
 
+// 判断列表中是否包含指定元素。
+func (list *LinkedList[T]) Contains(item T) bool {
+	return list.IndexOf(item) >= 0
+}
+
+// 在一次遍历中删除所有满足 filter 的元素，时间复杂度为O(n)；filter 不能修改列表。
+func (list *LinkedList[T]) RemoveIf(filter func(item T) bool) bool {
+	expectedModCount := list.modCount
+	removed := 0
+	var prev *Node[T]
+	for node := list.head; node != nil; node = node.next {
+		drop := filter(node.value)
+		if list.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
+		}
+		if !drop {
+			prev = node
+		} else if prev == nil {
+			list.head = node.next
+			removed++
+		} else {
+			prev.next = node.next
+			removed++
+		}
+	}
+	if removed == 0 {
+		return false
+	}
+	list.size -= removed
+	list.modCount++
+	return true
+}
+
+// 检查列表中是否包含 c 中的所有元素。
+func (list *LinkedList[T]) ContainsAll(c collection.Collection[T]) bool {
+	for item := range c.All() {
+		if !list.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// 删除列表中包含在 c 中的所有元素。
+func (list *LinkedList[T]) RemoveAll(c collection.Collection[T]) bool {
+	return list.RemoveIf(c.Contains)
+}
+
+// 只保留列表中包含在 c 中的元素。
+func (list *LinkedList[T]) RetainAll(c collection.Collection[T]) bool {
+	return list.RemoveIf(func(item T) bool { return !c.Contains(item) })
+}
+
+// 将每个元素替换为 operator 作用于该元素的结果，operator 不能修改列表。
+func (list *LinkedList[T]) ReplaceAll(operator func(item T) T) {
+	expectedModCount := list.modCount
+	for node := list.head; node != nil; node = node.next {
+		value := operator(node.value)
+		if list.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
+		}
+		node.value = value
+	}
+}
+
+// 检查 other 是否按相同顺序包含相等的元素，other 可以是任意 collection.List 实现。
+func (list *LinkedList[T]) Equals(other collection.List[T]) bool {
+	if other == nil || list.size != other.Size() {
+		return false
+	}
+	node := list.head
+	for item := range other.All() {
+		if node == nil || !collection.Equal(node.value, item) {
+			return false
+		}
+		node = node.next
+	}
+	return node == nil
+}
+
+// 按 Java 的 List.hashCode 计算列表的哈希码，相等的列表哈希码相同。
+func (list *LinkedList[T]) HashCode() uint64 {
+	var hash uint64 = 1
+	for node := list.head; node != nil; node = node.next {
+		hash = 31*hash + collection.HashCode(node.value)
+	}
+	return hash
+}
+
+// 返回指定元素在列表中第一次出现的位置，不存在时返回-1。
+func (list *LinkedList[T]) IndexOf(item T) int {
+	index := 0
+	for current := list.head; current != nil; current = current.next {
+		if collection.Equal(current.value, item) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// 返回指定元素在列表中最后一次出现的位置，不存在时返回-1。
+func (list *LinkedList[T]) LastIndexOf(item T) int {
+	last := -1
+	index := 0
+	for current := list.head; current != nil; current = current.next {
+		if collection.Equal(current.value, item) {
+			last = index
+		}
+		index++
+	}
+	return last
+}
+
+// 清空列表中的所有元素。
+func (list *LinkedList[T]) Clear() {
+	list.head = nil
+	list.size = 0
+	list.modCount++
+}
+
+// 按比较函数 cmp 对列表进行稳定排序，cmp 的约定与 slices.SortFunc 相同。
+// 使用自底向上的归并排序，只重新链接节点而不复制元素，时间复杂度为O(n log n)，额外空间为O(1)。
+func (list *LinkedList[T]) Sort(cmp func(a, b T) int) {
+	list.head = mergeSort(list.head, list.size, cmp)
+	list.modCount++
+}
+
+// mergeSort 对以 head 开头、长度为 size 的链表进行自底向上的归并排序，只沿 next 重新链接节点，返回排序后的头节点。
+func mergeSort[T comparable](head *Node[T], size int, cmp func(a, b T) int) *Node[T] {
+	dummy := &Node[T]{next: head}
+	for width := 1; width < size; width *= 2 {
+		tail, rest := dummy, dummy.next
+		for rest != nil {
+			left := rest
+			right := split(left, width)
+			rest = split(right, width)
+			tail.next, tail = merge(left, right, cmp)
+		}
+	}
+	return dummy.next
+}
+
+// split 在以 head 开头的链表的第 n 个节点之后断开，返回后半部分的头节点。
+func split[T comparable](head *Node[T], n int) *Node[T] {
+	for i := 1; head != nil && i < n; i++ {
+		head = head.next
+	}
+	if head == nil {
+		return nil
+	}
+	rest := head.next
+	head.next = nil
+	return rest
+}
+
+// merge 合并两个有序链表，相等的元素中 a 的排在前面，返回合并后的头节点与尾节点。
+func merge[T comparable](a, b *Node[T], cmp func(a, b T) int) (head, tail *Node[T]) {
+	dummy := &Node[T]{}
+	tail = dummy
+	for a != nil && b != nil {
+		if cmp(b.value, a.value) < 0 {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
+}
+
+// 将列表转换为切片。
+func (list *LinkedList[T]) ToSlice() []T {
+	slice := make([]T, 0, list.size)
+	for current := list.head; current != nil; current = current.next {
+		slice = append(slice, current.value)
+	}
+	return slice
+}
+
 // 获取指定位置的节点。
 func (list *LinkedList[T]) getNode(index int) *Node[T] {
 	current := list.head
@@ -127,8 +365,8 @@ func (list *List[T]) Set(index int, item T) {
 }
 
 // 删除列表中指定位置的元素。
-func (list *List[T]) RemoveAt(index int) bool {
-	return list.linkedList.Remove(index)
+func (list *List[T]) RemoveAt(index int) {
+	list.linkedList.Remove(index)
 }
 
 // 返回列表中的元素数量。
@@ -144,12 +382,99 @@ func (list *List[T]) IsEmpty() bool {
 func (list *LinkedList[T]) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("[")
-	for i := 0; i < list.size; i++ {
-		if i > 0 {
+	for current := list.head; current != nil; current = current.next {
+		if current != list.head {
 			buffer.WriteString(",")
 		}
-		buffer.WriteString(fmt.Sprintf("%v", list.Get(i)))
+		buffer.WriteString(fmt.Sprintf("%v", current.value))
 	}
 	buffer.WriteString("]")
 	return buffer.String()
 }
+
+// 返回按从头到尾的顺序产出所有元素的序列。
+func (list *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := list.modCount
+		for current := list.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+			if list.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+// 返回按从尾到头的顺序产出所有元素的序列，单向链表需要先复制出一份元素快照。
+func (list *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := list.modCount
+		slice := list.ToSlice()
+		for i := len(slice) - 1; i >= 0; i-- {
+			if !yield(slice[i]) {
+				return
+			}
+			if list.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+// 返回遍历列表元素的迭代器。
+func (list *LinkedList[T]) Iterator() collection.Iterator[T] {
+	return &iterator[T]{list: list, next: list.head, expectedModCount: list.modCount}
+}
+
+type iterator[T comparable] struct {
+	list             *LinkedList[T] // 被遍历的链表
+	next             *Node[T]       // 下一次 Next 返回的节点
+	last             *Node[T]       // 最近一次 Next 返回的节点
+	beforeLast       *Node[T]       // last 的前驱节点，单向链表删除时需要
+	expectedModCount int            // 迭代器认可的链表修改次数
+}
+
+// 检查链表是否在迭代器之外被结构性修改。
+func (it *iterator[T]) checkForComodification() {
+	if it.list.modCount != it.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+// 判断是否还有下一个元素。
+func (it *iterator[T]) HasNext() bool {
+	return it.next != nil
+}
+
+// 返回下一个元素。
+func (it *iterator[T]) Next() T {
+	it.checkForComodification()
+	if it.next == nil {
+		panic(collection.ErrNoSuchElement)
+	}
+	if it.last != nil {
+		it.beforeLast = it.last
+	}
+	it.last = it.next
+	it.next = it.next.next
+	return it.last.value
+}
+
+// 删除最近一次 Next 返回的元素。
+func (it *iterator[T]) Remove() {
+	if it.last == nil {
+		panic(collection.ErrIllegalState)
+	}
+	it.checkForComodification()
+	if it.beforeLast == nil {
+		it.list.head = it.last.next
+	} else {
+		it.beforeLast.next = it.last.next
+	}
+	it.list.size--
+	it.list.modCount++
+	it.expectedModCount = it.list.modCount
+	it.last = nil
+}
@@ -0,0 +1,164 @@
+package linkedlist
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// item 按 key 排序，seq 记录原始位置，用于检查排序的稳定性。
+type item struct {
+	key, seq int
+}
+
+func compareItems(a, b item) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// sortInputs 返回长度为 n 的各种分布的键，包括长度在 7 与 32 附近的升序和降序段。
+func sortInputs(n int) map[string][]int {
+	rnd := rand.New(rand.NewPCG(uint64(n), 2))
+	inputs := map[string][]int{}
+	generate := func(name string, key func(i int) int) {
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = key(i)
+		}
+		inputs[name] = keys
+	}
+	generate("random", func(int) int { return rnd.IntN(n/4 + 1) })
+	generate("few distinct", func(int) int { return rnd.IntN(3) })
+	generate("ascending", func(i int) int { return i / 3 })
+	generate("descending", func(i int) int { return (n - i) / 3 })
+	for _, run := range []int{6, 7, 8, 31, 32, 33} {
+		generate(fmt.Sprintf("runs of %d", run), func(i int) int {
+			block, offset := i/run, i%run
+			if block%2 == 0 {
+				return block*run/2 + offset/2
+			}
+			return block*run/2 + (run-offset)/2
+		})
+	}
+	return inputs
+}
+
+func TestSortMatchesStableSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 31, 32, 33, 64, 65, 100, 1000, 4097} {
+		for name, keys := range sortInputs(n) {
+			list := NewLinkedList[item]()
+			want := make([]item, len(keys))
+			for i, key := range keys {
+				want[i] = item{key, i}
+				list.Add(want[i])
+			}
+			slices.SortStableFunc(want, compareItems)
+			list.Sort(compareItems)
+
+			if got := list.ToSlice(); !slices.Equal(got, want) {
+				t.Fatalf("%s (n=%d): result differs from slices.SortStableFunc", name, n)
+			}
+			if list.Size() != n {
+				t.Fatalf("%s (n=%d): Size() = %d after Sort", name, n, list.Size())
+			}
+			// 排序后仍能在末尾追加，说明链表的结构完好
+			list.Add(item{-1, -1})
+			if last := list.Get(n); last != (item{-1, -1}) {
+				t.Fatalf("%s (n=%d): Add after Sort appended %v", name, n, last)
+			}
+		}
+	}
+}
+
+// expectPanic 检查 f 以包装了 target 的错误panic。
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, target) {
+			t.Fatalf("panic = %v, want %v", err, target)
+		}
+	}()
+	f()
+}
+
+// operator 修改列表时 ReplaceAll 必须以 ErrConcurrentModification panic，触发修改的元素不会被写回。
+func TestReplaceAllDetectsModification(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(list *LinkedList[int])
+		want   []int
+	}{
+		{"Clear", func(list *LinkedList[int]) { list.Clear() }, []int{}},
+		{"Remove", func(list *LinkedList[int]) { list.Remove(0) }, []int{-1, 2, 3}},
+		{"Add", func(list *LinkedList[int]) { list.Add(100) }, []int{0, -1, 2, 3, 100}},
+	}
+	for _, tt := range tests {
+		list := NewLinkedList[int]()
+		list.AddAll(0, 1, 2, 3)
+		expectPanic(t, collection.ErrConcurrentModification, func() {
+			list.ReplaceAll(func(item int) int {
+				if item == 2 {
+					tt.modify(list)
+				}
+				return -item
+			})
+		})
+		if got := list.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: list = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// 批量操作保持剩余元素的顺序，只在列表被修改时返回true。
+func TestBulkOperations(t *testing.T) {
+	of := func(items ...int) *LinkedList[int] {
+		list := NewLinkedList[int]()
+		list.AddAll(items...)
+		return list
+	}
+	tests := []struct {
+		name    string
+		op      func(list *LinkedList[int]) bool
+		changed bool
+		want    []int
+	}{
+		{"RemoveIf", func(list *LinkedList[int]) bool { return list.RemoveIf(func(item int) bool { return item%2 == 0 }) }, true, []int{1, 3, 1, 5}},
+		{"RemoveIf none", func(list *LinkedList[int]) bool { return list.RemoveIf(func(item int) bool { return item > 10 }) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RemoveIf all", func(list *LinkedList[int]) bool { return list.RemoveIf(func(int) bool { return true }) }, true, []int{}},
+		{"RemoveAll", func(list *LinkedList[int]) bool { return list.RemoveAll(of(2, 5, 9)) }, true, []int{1, 3, 1, 4}},
+		{"RemoveAll disjoint", func(list *LinkedList[int]) bool { return list.RemoveAll(of(7, 8)) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RemoveAll self", func(list *LinkedList[int]) bool { return list.RemoveAll(list) }, true, []int{}},
+		{"RetainAll", func(list *LinkedList[int]) bool { return list.RetainAll(of(4, 2, 9)) }, true, []int{2, 2, 4}},
+		{"RetainAll superset", func(list *LinkedList[int]) bool { return list.RetainAll(of(1, 2, 3, 4, 5, 6)) }, false, []int{1, 2, 3, 2, 1, 4, 5}},
+		{"RetainAll empty", func(list *LinkedList[int]) bool { return list.RetainAll(of()) }, true, []int{}},
+	}
+	for _, tt := range tests {
+		list := of(1, 2, 3, 2, 1, 4, 5)
+		if changed := tt.op(list); changed != tt.changed {
+			t.Errorf("%s returned %v, want %v", tt.name, changed, tt.changed)
+		}
+		if got := list.ToSlice(); !slices.Equal(got, tt.want) || list.Size() != len(tt.want) {
+			t.Errorf("%s: list = %v (size %d), want %v", tt.name, got, list.Size(), tt.want)
+		}
+	}
+
+	list := of(1, 2, 3, 2)
+	list.ReplaceAll(func(item int) int { return item * 10 })
+	if got, want := list.ToSlice(), []int{10, 20, 30, 20}; !slices.Equal(got, want) {
+		t.Errorf("after ReplaceAll: list = %v, want %v", got, want)
+	}
+	list = of(1, 2, 3, 2)
+	if !list.ContainsAll(of(2, 3, 2)) || !list.ContainsAll(of()) || list.ContainsAll(of(1, 4)) {
+		t.Error("ContainsAll disagrees with Contains")
+	}
+	empty := of()
+	if empty.RemoveIf(func(int) bool { return true }) || empty.RetainAll(of(1)) || !empty.IsEmpty() {
+		t.Error("bulk operations on an empty list reported a change")
+	}
+}
@@ -2,12 +2,16 @@ package hashset
 
 import (
 	"fmt"
-	"gitee.com/herry-hu/go-collections-java/map/hashmap"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/map/hashmap"
+	"iter"
 	"strings"
 	"sync"
 )
 
-// HashSet 是一个线程安全的哈希集合
+var _ collection.Set[int] = (*HashSet[int])(nil)
+
+// HashSet 是一个线程安全的哈希集合，元素实现了 lang.Hashable 时按其 Equals 与 HashCode 去重
 type HashSet[T comparable] struct {
 	items *hashmap.HashMap[T, int] // 存储元素的哈希集合
 	lock  sync.RWMutex             // 用于保护集合的读写锁
@@ -29,6 +33,16 @@ func (set *HashSet[T]) Add(key T) {
 	set.items.Put(key, 1)
 }
 
+// AddAll 将指定的所有元素添加到HashSet
+func (set *HashSet[T]) AddAll(keys ...T) {
+	set.lock.Lock()
+	defer set.lock.Unlock()
+
+	for _, key := range keys {
+		set.items.Put(key, 1)
+	}
+}
+
 // Contains 检查HashSet中是否包含指定的元素
 func (set *HashSet[T]) Contains(key T) bool {
 	set.lock.RLock()
@@ -38,12 +52,94 @@ func (set *HashSet[T]) Contains(key T) bool {
 	return found
 }
 
-// Remove 从HashSet中移除指定的元素
-func (set *HashSet[T]) Remove(key T) {
+// Remove 从HashSet中移除指定的元素，元素存在时返回true
+func (set *HashSet[T]) Remove(key T) bool {
+	set.lock.Lock()
+	defer set.lock.Unlock()
+
+	return set.items.Delete(key)
+}
+
+// ContainsAll 检查HashSet中是否包含 c 中的所有元素
+func (set *HashSet[T]) ContainsAll(c collection.Collection[T]) bool {
+	for key := range c.All() {
+		if !set.Contains(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveIf 持有写锁在一次遍历中删除所有满足 filter 的元素，有元素被删除时返回true；filter 不能调用集合的方法，否则会死锁
+func (set *HashSet[T]) RemoveIf(filter func(key T) bool) bool {
 	set.lock.Lock()
 	defer set.lock.Unlock()
 
-	set.items.Delete(key)
+	removed := false
+	for it := set.items.KeyIterator(); it.HasNext(); {
+		if filter(it.Next()) {
+			it.Remove()
+			removed = true
+		}
+	}
+	return removed
+}
+
+// RemoveAll 删除所有包含在 c 中的元素，有元素被删除时返回true；持有写锁期间会调用 c.Contains，c 为集合本身时直接清空
+func (set *HashSet[T]) RemoveAll(c collection.Collection[T]) bool {
+	if any(c) == any(set) {
+		set.lock.Lock()
+		defer set.lock.Unlock()
+
+		removed := !set.items.IsEmpty()
+		set.items.Clear()
+		return removed
+	}
+	return set.RemoveIf(c.Contains)
+}
+
+// RetainAll 只保留包含在 c 中的元素，有元素被删除时返回true；持有写锁期间会调用 c.Contains，c 为集合本身时不做修改
+func (set *HashSet[T]) RetainAll(c collection.Collection[T]) bool {
+	if any(c) == any(set) {
+		return false
+	}
+	return set.RemoveIf(func(key T) bool { return !c.Contains(key) })
+}
+
+// ReplaceAll 将每个元素替换为 operator 作用于该元素的结果，替换后相同的元素只保留一个
+func (set *HashSet[T]) ReplaceAll(operator func(key T) T) {
+	keys := set.ToSlice()
+	for i, key := range keys {
+		keys[i] = operator(key)
+	}
+
+	set.lock.Lock()
+	defer set.lock.Unlock()
+
+	set.items.Clear()
+	for _, key := range keys {
+		set.items.Put(key, 1)
+	}
+}
+
+// Equals 检查 other 与HashSet包含相同的元素，other 可以是任意 collection.Set 实现，例如 TreeSet
+func (set *HashSet[T]) Equals(other collection.Set[T]) bool {
+	if other == nil {
+		return false
+	}
+	if any(other) == any(set) {
+		return true
+	}
+	return set.Size() == other.Size() && set.ContainsAll(other)
+}
+
+// HashCode 返回所有元素哈希码之和，与元素的遍历顺序无关
+func (set *HashSet[T]) HashCode() uint64 {
+	var hash uint64
+	for _, key := range set.ToSlice() {
+		hash += collection.HashCode(key)
+	}
+	return hash
 }
 
 // Size 返回HashSet中的元素数量
@@ -70,6 +166,18 @@ func (set *HashSet[T]) IsEmpty() bool {
 	return set.items.IsEmpty()
 }
 
+// ToSlice 将HashSet中的元素复制到一个新的切片中
+func (set *HashSet[T]) ToSlice() []T {
+	set.lock.RLock()
+	defer set.lock.RUnlock()
+
+	slice := make([]T, 0, set.items.Size())
+	set.items.ForEach(func(key T, _ int) {
+		slice = append(slice, key)
+	})
+	return slice
+}
+
 // String 返回HashSet的字符串表示形式
 func (set *HashSet[T]) String() string {
 	set.lock.RLock()
@@ -82,8 +190,10 @@ func (set *HashSet[T]) String() string {
 	return fmt.Sprintf("HashSet{%s}", strings.Join(items, ", "))
 }
 
-// Iterator 返回一个只读通道，用于遍历HashSet中的元素
-func (set *HashSet[T]) Iterator() <-chan T {
+// Iter 返回一个只读通道，用于遍历HashSet中的元素
+//
+// Deprecated: 提前退出遍历会泄漏生产者goroutine，请使用 All 或 Iterator。
+func (set *HashSet[T]) Iter() <-chan T {
 	ch := make(chan T)
 
 	go func() {
@@ -98,3 +208,50 @@ func (set *HashSet[T]) Iterator() <-chan T {
 
 	return ch
 }
+
+// All 返回产出HashSet所有元素的序列，序列遍历的是开始遍历时的元素快照，遍历中可以安全地修改集合
+func (set *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, key := range set.ToSlice() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator 返回遍历HashSet元素的迭代器，迭代器遍历的是创建时的元素快照
+func (set *HashSet[T]) Iterator() collection.Iterator[T] {
+	return &iterator[T]{set: set, items: set.ToSlice(), last: -1}
+}
+
+type iterator[T comparable] struct {
+	set    *HashSet[T] // 被遍历的集合
+	items  []T         // 创建迭代器时的元素快照
+	cursor int         // 下一次 Next 返回的元素下标
+	last   int         // 最近一次 Next 返回的元素下标
+}
+
+// HasNext 检查是否还有下一个元素
+func (it *iterator[T]) HasNext() bool {
+	return it.cursor < len(it.items)
+}
+
+// Next 返回下一个元素
+func (it *iterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.last = it.cursor
+	it.cursor++
+	return it.items[it.last]
+}
+
+// Remove 从HashSet中删除最近一次 Next 返回的元素
+func (it *iterator[T]) Remove() {
+	if it.last < 0 {
+		panic(collection.ErrIllegalState)
+	}
+	it.set.Remove(it.items[it.last])
+	it.last = -1
+}
@@ -0,0 +1,118 @@
+package hashset
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/lang"
+)
+
+// sorted 返回集合元素排序后的切片，HashSet 的遍历顺序不确定。
+func sorted(set *HashSet[int]) []int {
+	items := set.ToSlice()
+	slices.Sort(items)
+	return items
+}
+
+func TestBulkOperations(t *testing.T) {
+	of := func(items ...int) *HashSet[int] {
+		set := NewHashSet[int]()
+		set.AddAll(items...)
+		return set
+	}
+	tests := []struct {
+		name    string
+		op      func(set *HashSet[int]) bool
+		changed bool
+		want    []int
+	}{
+		{"RemoveIf", func(set *HashSet[int]) bool { return set.RemoveIf(func(key int) bool { return key%2 == 0 }) }, true, []int{1, 3, 5}},
+		{"RemoveIf none", func(set *HashSet[int]) bool { return set.RemoveIf(func(key int) bool { return key > 10 }) }, false, []int{1, 2, 3, 4, 5}},
+		{"RemoveIf all", func(set *HashSet[int]) bool { return set.RemoveIf(func(int) bool { return true }) }, true, []int{}},
+		{"RemoveAll", func(set *HashSet[int]) bool { return set.RemoveAll(of(2, 5, 9)) }, true, []int{1, 3, 4}},
+		{"RemoveAll disjoint", func(set *HashSet[int]) bool { return set.RemoveAll(of(7, 8)) }, false, []int{1, 2, 3, 4, 5}},
+		{"RemoveAll self", func(set *HashSet[int]) bool { return set.RemoveAll(set) }, true, []int{}},
+		{"RetainAll", func(set *HashSet[int]) bool { return set.RetainAll(of(4, 2, 9)) }, true, []int{2, 4}},
+		{"RetainAll superset", func(set *HashSet[int]) bool { return set.RetainAll(of(1, 2, 3, 4, 5, 6)) }, false, []int{1, 2, 3, 4, 5}},
+		{"RetainAll self", func(set *HashSet[int]) bool { return set.RetainAll(set) }, false, []int{1, 2, 3, 4, 5}},
+		{"RetainAll empty", func(set *HashSet[int]) bool { return set.RetainAll(of()) }, true, []int{}},
+	}
+	for _, tt := range tests {
+		set := of(1, 2, 3, 4, 5)
+		if changed := tt.op(set); changed != tt.changed {
+			t.Errorf("%s returned %v, want %v", tt.name, changed, tt.changed)
+		}
+		if got := sorted(set); !slices.Equal(got, tt.want) || set.Size() != len(tt.want) {
+			t.Errorf("%s: set = %v (size %d), want %v", tt.name, got, set.Size(), tt.want)
+		}
+	}
+
+	// 替换后相同的元素只保留一个
+	set := of(1, 2, 3, 4, 5)
+	set.ReplaceAll(func(key int) int { return key / 2 })
+	if got, want := sorted(set), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("after ReplaceAll: set = %v, want %v", got, want)
+	}
+
+	set = of(1, 2, 3)
+	if !set.ContainsAll(of(3, 1)) || !set.ContainsAll(of()) || set.ContainsAll(of(1, 4)) {
+		t.Error("ContainsAll disagrees with Contains")
+	}
+	empty := of()
+	if empty.RemoveIf(func(int) bool { return true }) || empty.RemoveAll(empty) || empty.RetainAll(of(1)) {
+		t.Error("bulk operations on an empty set reported a change")
+	}
+}
+
+// RemoveIf 在一次遍历中删除元素，删除大量元素时剩余元素仍然可以找到。
+func TestRemoveIfLargeSet(t *testing.T) {
+	set := NewHashSet[int]()
+	for i := 0; i < 10000; i++ {
+		set.Add(i)
+	}
+	if !set.RemoveIf(func(key int) bool { return key%3 != 0 }) {
+		t.Fatal("RemoveIf returned false")
+	}
+	if set.Size() != 3334 {
+		t.Fatalf("size = %d, want 3334", set.Size())
+	}
+	for i := 0; i < 10000; i++ {
+		if set.Contains(i) != (i%3 == 0) {
+			t.Fatalf("Contains(%d) = %v", i, set.Contains(i))
+		}
+	}
+}
+
+// caseInsensitive 是忽略大小写比较的字符串，实现了 lang.Hashable。
+type caseInsensitive string
+
+func (s caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+func (s caseInsensitive) HashCode() uint64 {
+	return lang.String(strings.ToLower(string(s))).HashCode()
+}
+
+func TestHashableElements(t *testing.T) {
+	set := NewHashSet[caseInsensitive]()
+	set.AddAll("Go", "GO", "go", "Java", "JAVA")
+	if set.Size() != 2 || !set.Contains("gO") || !set.Contains("java") {
+		t.Fatalf("set = %v, want two elements matched case-insensitively", set)
+	}
+
+	other := NewHashSet[caseInsensitive]()
+	other.AddAll("JAVA", "gO")
+	if !set.Equals(other) || set.HashCode() != other.HashCode() {
+		t.Fatal("sets whose elements differ only in case are not equal")
+	}
+
+	if !set.RemoveAll(other) || !set.IsEmpty() {
+		t.Fatalf("RemoveAll left %v", set)
+	}
+	set.AddAll("Go", "Rust")
+	if !set.Remove("RUST") || set.Remove("rust") || set.Size() != 1 {
+		t.Fatalf("Remove ignores Equals: set = %v", set)
+	}
+}
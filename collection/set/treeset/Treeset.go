@@ -1,23 +1,42 @@
 package treeset
 
 import (
+	"cmp"
 	"container/list"
 	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
 	"github.com/herry-hu/go-collections-java/lang"
+	"iter"
 	"reflect"
+	"slices"
 	"strings"
 )
 
+var _ collection.SortedSet[lang.Int] = (*TreeSet[lang.Int])(nil)
+
 // TreeSet 是一个基于红黑树实现的有序集合。
-type TreeSet[T lang.Comparable] struct {
-	set *list.List   // 使用双向链表存储元素
-	typ reflect.Type // 元素类型
+type TreeSet[T any] struct {
+	set      *list.List       // 使用双向链表存储元素
+	cmp      func(a, b T) int // 元素的比较函数
+	typ      reflect.Type     // 元素类型
+	modCount int              // 结构性修改次数，用于迭代时的快速失败检测
+}
+
+// NewTreeSet 创建一个新的 TreeSet 实例，元素按 CompareTo 定义的顺序排列。
+func NewTreeSet[T lang.Comparable[T]]() *TreeSet[T] {
+	return NewTreeSetFunc(lang.ComparableOrder[T]())
 }
 
-// NewTreeSet 创建一个新的 TreeSet 实例。
-func NewTreeSet[T lang.Comparable]() *TreeSet[T] {
+// NewOrderedTreeSet 创建一个按自然顺序排列 int、string 等原生有序类型的 TreeSet 实例。
+func NewOrderedTreeSet[T cmp.Ordered]() *TreeSet[T] {
+	return NewTreeSetFunc(cmp.Compare[T])
+}
+
+// NewTreeSetFunc 创建一个按比较函数排列元素的 TreeSet 实例，比较结果为0的元素被视为重复元素。
+func NewTreeSetFunc[T any](compare func(a, b T) int) *TreeSet[T] {
 	return &TreeSet[T]{
 		set: list.New(),
+		cmp: compare,
 	}
 }
 
@@ -25,15 +44,17 @@ func NewTreeSet[T lang.Comparable]() *TreeSet[T] {
 func (set *TreeSet[T]) Add(value T) {
 	// 向集合中添加指定元素
 	for e := set.set.Front(); e != nil; e = e.Next() {
-		cmp := e.Value.(lang.Comparable).CompareTo(value)
+		cmp := set.cmp(e.Value.(T), value)
 		if cmp == 0 {
 			return // 元素已存在，不重复添加
 		} else if cmp > 0 {
 			set.set.InsertBefore(value, e)
+			set.modCount++
 			return
 		}
 	}
 	set.set.PushBack(value)
+	set.modCount++
 
 	// 更新元素类型
 	if set.typ == nil {
@@ -41,15 +62,23 @@ func (set *TreeSet[T]) Add(value T) {
 	}
 }
 
+// AddAll 向集合中添加指定的所有元素。
+func (set *TreeSet[T]) AddAll(items ...T) {
+	for _, item := range items {
+		set.Add(item)
+	}
+}
+
 // Clear 清空集合中的所有元素。
 func (set *TreeSet[T]) Clear() {
 	set.set = list.New()
+	set.modCount++
 }
 
 // Contains 检查集合中是否包含指定元素。
 func (set *TreeSet[T]) Contains(item T) bool {
 	for e := set.set.Front(); e != nil; e = e.Next() {
-		cmp := e.Value.(lang.Comparable).CompareTo(item)
+		cmp := set.cmp(e.Value.(T), item)
 		if cmp == 0 {
 			return true
 		} else if cmp > 0 {
@@ -59,15 +88,52 @@ func (set *TreeSet[T]) Contains(item T) bool {
 	return false
 }
 
-// First 返回集合中的第一个元素。
+// First 返回集合中的第一个元素，集合为空时以 collection.ErrNoSuchElement panic，与 Java 的 TreeSet.first 一致。
 func (set *TreeSet[T]) First() T {
-	var t T
 	if set.set.Len() == 0 {
-		return t
+		panic(collection.ErrNoSuchElement)
 	}
 	return set.set.Front().Value.(T)
 }
 
+// Last 返回集合中的最后一个元素，集合为空时以 collection.ErrNoSuchElement panic。
+func (set *TreeSet[T]) Last() T {
+	if set.set.Len() == 0 {
+		panic(collection.ErrNoSuchElement)
+	}
+	return set.set.Back().Value.(T)
+}
+
+// TryFirst 返回集合中的第一个元素，集合为空时返回 collection.ErrNoSuchElement。
+func (set *TreeSet[T]) TryFirst() (T, error) {
+	if set.set.Len() == 0 {
+		var t T
+		return t, collection.ErrNoSuchElement
+	}
+	return set.set.Front().Value.(T), nil
+}
+
+// TryLast 返回集合中的最后一个元素，集合为空时返回 collection.ErrNoSuchElement。
+func (set *TreeSet[T]) TryLast() (T, error) {
+	if set.set.Len() == 0 {
+		var t T
+		return t, collection.ErrNoSuchElement
+	}
+	return set.set.Back().Value.(T), nil
+}
+
+// FirstOpt 以 lang.Optional 返回集合中的第一个元素，集合为空时返回 lang.Empty。
+func (set *TreeSet[T]) FirstOpt() lang.Optional[T] {
+	first, err := set.TryFirst()
+	return lang.OfOk(first, err == nil)
+}
+
+// LastOpt 以 lang.Optional 返回集合中的最后一个元素，集合为空时返回 lang.Empty。
+func (set *TreeSet[T]) LastOpt() lang.Optional[T] {
+	last, err := set.TryLast()
+	return lang.OfOk(last, err == nil)
+}
+
 // IsEmpty 检查集合是否为空。
 func (set *TreeSet[T]) IsEmpty() bool {
 	return set.set.Len() == 0
@@ -83,22 +149,166 @@ func (set *TreeSet[T]) Set(items ...T) {
 }
 
 // Iter 返回一个通道，用于迭代集合中的元素。
+//
+// 通道产出的是调用 Iter 时的元素快照，之后对集合的修改不会影响通道中的元素。
+//
+// Deprecated: 提前退出遍历会泄漏生产者goroutine，请使用 All 或 Iterator。
 func (set *TreeSet[T]) Iter() <-chan T {
+	// 在调用方的goroutine中取快照，生产者goroutine不再访问集合本身
+	items := set.ToSlice()
 	ch := make(chan T)
 	go func() {
 		defer close(ch)
-		for e := set.set.Front(); e != nil; e = e.Next() {
-			ch <- e.Value.(T)
+		for _, item := range items {
+			ch <- item
 		}
 	}()
 	return ch
 }
 
+// All 返回按升序产出所有元素的序列。
+func (set *TreeSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := set.modCount
+		for e := set.set.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.(T)) {
+				return
+			}
+			if set.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+// Backward 返回按降序产出所有元素的序列。
+func (set *TreeSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		expectedModCount := set.modCount
+		for e := set.set.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value.(T)) {
+				return
+			}
+			if set.modCount != expectedModCount {
+				panic(collection.ErrConcurrentModification)
+			}
+		}
+	}
+}
+
+// Iterator 返回按升序遍历集合元素的迭代器。
+func (set *TreeSet[T]) Iterator() collection.Iterator[T] {
+	return &iterator[T]{set: set, next: set.set.Front(), expectedModCount: set.modCount}
+}
+
+type iterator[T any] struct {
+	set              *TreeSet[T]   // 被遍历的集合
+	next             *list.Element // 下一次 Next 返回的元素
+	last             *list.Element // 最近一次 Next 返回的元素
+	expectedModCount int           // 迭代器认可的集合修改次数
+}
+
+// checkForComodification 检查集合是否在迭代器之外被结构性修改。
+func (it *iterator[T]) checkForComodification() {
+	if it.set.modCount != it.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+// HasNext 检查是否还有下一个元素。
+func (it *iterator[T]) HasNext() bool {
+	return it.next != nil
+}
+
+// Next 返回下一个元素。
+func (it *iterator[T]) Next() T {
+	it.checkForComodification()
+	if it.next == nil {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.last = it.next
+	it.next = it.next.Next()
+	return it.last.Value.(T)
+}
+
+// Remove 从集合中删除最近一次 Next 返回的元素。
+func (it *iterator[T]) Remove() {
+	if it.last == nil {
+		panic(collection.ErrIllegalState)
+	}
+	it.checkForComodification()
+	it.set.set.Remove(it.last)
+	it.set.modCount++
+	it.expectedModCount = it.set.modCount
+	it.last = nil
+}
+
+// Spliterator 返回按升序遍历并可拆分集合元素的 Spliterator。
+func (set *TreeSet[T]) Spliterator() collection.Spliterator[T] {
+	return &spliterator[T]{set: set, current: set.set.Front(), est: set.set.Len(), expectedModCount: set.modCount}
+}
+
+// spliterator 负责元素区间 [current, fence)，fence 为nil表示一直到链表末尾。
+type spliterator[T any] struct {
+	set              *TreeSet[T]   // 被遍历的集合
+	current          *list.Element // 下一个待访问的元素
+	fence            *list.Element // 区间的上界（不含）
+	est              int           // 区间内剩余元素的精确数量
+	expectedModCount int           // 认可的集合修改次数
+}
+
+// TryAdvance 对下一个元素执行 action。
+func (s *spliterator[T]) TryAdvance(action func(T)) bool {
+	if s.current == s.fence {
+		return false
+	}
+	e := s.current
+	s.current = e.Next()
+	s.est--
+	action(e.Value.(T))
+	if s.set.modCount != s.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+	return true
+}
+
+// ForEachRemaining 对剩余的所有元素执行 action。
+func (s *spliterator[T]) ForEachRemaining(action func(T)) {
+	for s.TryAdvance(action) {
+	}
+}
+
+// TrySplit 将前一半元素拆分为新的 Spliterator，链表无法随机访问，需要沿链表走到中点。
+func (s *spliterator[T]) TrySplit() collection.Spliterator[T] {
+	half := s.est / 2
+	if half == 0 {
+		return nil
+	}
+	mid := s.current
+	for i := 0; i < half; i++ {
+		mid = mid.Next()
+	}
+	prefix := &spliterator[T]{set: s.set, current: s.current, fence: mid, est: half, expectedModCount: s.expectedModCount}
+	s.current = mid
+	s.est -= half
+	return prefix
+}
+
+// EstimateSize 返回剩余元素的数量。
+func (s *spliterator[T]) EstimateSize() int {
+	return s.est
+}
+
+// Characteristics 返回 Spliterator 的特征。
+func (s *spliterator[T]) Characteristics() collection.Characteristics {
+	return collection.Ordered | collection.Distinct | collection.Sorted | collection.Sized | collection.Subsized
+}
+
 // String 返回集合的字符串表示形式。
 func (set *TreeSet[T]) String() string {
 	var values []string
-	for item := range set.Iter() {
-		values = append(values, fmt.Sprintf("%v", item))
+	for e := set.set.Front(); e != nil; e = e.Next() {
+		values = append(values, fmt.Sprintf("%v", e.Value))
 	}
 	return "treeSet{" + strings.Join(values, ", ") + "}"
 }
@@ -108,36 +318,121 @@ func (set *TreeSet[T]) Size() int {
 	return set.set.Len()
 }
 
-// Remove 从集合中移除指定的元素。
-func (set *TreeSet[T]) Remove(item T) {
+// Remove 从集合中移除指定的元素，元素存在时返回true。
+func (set *TreeSet[T]) Remove(item T) bool {
 	for e := set.set.Front(); e != nil; e = e.Next() {
-		if e.Value.(lang.Comparable).CompareTo(item) == 0 {
+		if set.cmp(e.Value.(T), item) == 0 {
 			set.set.Remove(e)
-			return
+			set.modCount++
+			return true
 		}
 	}
+	return false
+}
+
+// ContainsAll 检查集合中是否包含 c 中的所有元素。
+func (set *TreeSet[T]) ContainsAll(c collection.Collection[T]) bool {
+	for item := range c.All() {
+		if !set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveIf 在一次遍历中删除所有满足 filter 的元素，有元素被删除时返回true；filter 不能修改集合。
+func (set *TreeSet[T]) RemoveIf(filter func(item T) bool) bool {
+	expectedModCount := set.modCount
+	removed := false
+	for e := set.set.Front(); e != nil; {
+		next := e.Next()
+		drop := filter(e.Value.(T))
+		if set.modCount != expectedModCount {
+			panic(collection.ErrConcurrentModification)
+		}
+		if drop {
+			set.set.Remove(e)
+			removed = true
+		}
+		e = next
+	}
+	if removed {
+		set.modCount++
+	}
+	return removed
+}
+
+// RemoveAll 删除集合中所有包含在 c 中的元素，有元素被删除时返回true。
+func (set *TreeSet[T]) RemoveAll(c collection.Collection[T]) bool {
+	return set.RemoveIf(c.Contains)
+}
+
+// RetainAll 只保留集合中包含在 c 中的元素，有元素被删除时返回true。
+func (set *TreeSet[T]) RetainAll(c collection.Collection[T]) bool {
+	return set.RemoveIf(func(item T) bool { return !c.Contains(item) })
+}
+
+// ReplaceAll 将每个元素替换为 operator 作用于该元素的结果并重新排序，比较结果为0的元素只保留先出现的一个。
+func (set *TreeSet[T]) ReplaceAll(operator func(item T) T) {
+	items := set.ToSlice()
+	for i, item := range items {
+		items[i] = operator(item)
+	}
+	slices.SortStableFunc(items, set.cmp)
+	items = slices.CompactFunc(items, func(a, b T) bool { return set.cmp(a, b) == 0 })
+	set.set = list.New()
+	for _, item := range items {
+		set.set.PushBack(item)
+	}
+	set.modCount++
+}
+
+// Equals 检查 other 与集合包含相同的元素，元素是否相同由本集合的比较函数判断，other 可以是任意 collection.Set 实现。
+func (set *TreeSet[T]) Equals(other collection.Set[T]) bool {
+	if other == nil {
+		return false
+	}
+	return set.Size() == other.Size() && set.ContainsAll(other)
+}
+
+// HashCode 返回所有元素哈希码之和，与 HashSet 的 HashCode 一致。
+func (set *TreeSet[T]) HashCode() uint64 {
+	var hash uint64
+	for e := set.set.Front(); e != nil; e = e.Next() {
+		hash += collection.HashCode(e.Value.(T))
+	}
+	return hash
+}
+
+// ToSlice 按升序将集合中的元素复制到一个新的切片中。
+func (set *TreeSet[T]) ToSlice() []T {
+	slice := make([]T, 0, set.set.Len())
+	for e := set.set.Front(); e != nil; e = e.Next() {
+		slice = append(slice, e.Value.(T))
+	}
+	return slice
 }
 
-// Union 返回当前集合与另一个集合的并集。
+// Union 返回当前集合与另一个集合的并集，结果使用当前集合的比较函数。
 func (set *TreeSet[T]) Union(other *TreeSet[T]) *TreeSet[T] {
-	unionSet := NewTreeSet[T]()
+	unionSet := NewTreeSetFunc(set.cmp)
 
-	for item := range set.Iter() {
+	for _, item := range set.ToSlice() {
 		unionSet.Add(item)
 	}
 
-	for item := range other.Iter() {
+	for _, item := range other.ToSlice() {
 		unionSet.Add(item)
 	}
 
 	return unionSet
 }
 
-// Intersection 返回当前集合与另一个集合的交集。
+// Intersection 返回当前集合与另一个集合的交集，结果使用当前集合的比较函数。
 func (set *TreeSet[T]) Intersection(other *TreeSet[T]) *TreeSet[T] {
-	intersectionSet := NewTreeSet[T]()
+	intersectionSet := NewTreeSetFunc(set.cmp)
 
-	for item := range set.Iter() {
+	for _, item := range set.ToSlice() {
 		if other.Contains(item) {
 			intersectionSet.Add(item)
 		}
@@ -0,0 +1,90 @@
+package treeset
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+func TestBulkOperations(t *testing.T) {
+	of := func(items ...int) *TreeSet[int] {
+		set := NewOrderedTreeSet[int]()
+		set.AddAll(items...)
+		return set
+	}
+	tests := []struct {
+		name    string
+		op      func(set *TreeSet[int]) bool
+		changed bool
+		want    []int
+	}{
+		{"RemoveIf", func(set *TreeSet[int]) bool { return set.RemoveIf(func(item int) bool { return item%2 == 0 }) }, true, []int{1, 3, 5}},
+		{"RemoveIf none", func(set *TreeSet[int]) bool { return set.RemoveIf(func(item int) bool { return item > 10 }) }, false, []int{1, 2, 3, 4, 5}},
+		{"RemoveIf all", func(set *TreeSet[int]) bool { return set.RemoveIf(func(int) bool { return true }) }, true, []int{}},
+		{"RemoveAll", func(set *TreeSet[int]) bool { return set.RemoveAll(of(2, 5, 9)) }, true, []int{1, 3, 4}},
+		{"RemoveAll disjoint", func(set *TreeSet[int]) bool { return set.RemoveAll(of(7, 8)) }, false, []int{1, 2, 3, 4, 5}},
+		{"RemoveAll self", func(set *TreeSet[int]) bool { return set.RemoveAll(set) }, true, []int{}},
+		{"RetainAll", func(set *TreeSet[int]) bool { return set.RetainAll(of(4, 2, 9)) }, true, []int{2, 4}},
+		{"RetainAll superset", func(set *TreeSet[int]) bool { return set.RetainAll(of(1, 2, 3, 4, 5, 6)) }, false, []int{1, 2, 3, 4, 5}},
+		{"RetainAll self", func(set *TreeSet[int]) bool { return set.RetainAll(set) }, false, []int{1, 2, 3, 4, 5}},
+		{"RetainAll empty", func(set *TreeSet[int]) bool { return set.RetainAll(of()) }, true, []int{}},
+	}
+	for _, tt := range tests {
+		set := of(5, 3, 1, 4, 2)
+		if changed := tt.op(set); changed != tt.changed {
+			t.Errorf("%s returned %v, want %v", tt.name, changed, tt.changed)
+		}
+		if got := set.ToSlice(); !slices.Equal(got, tt.want) || set.Size() != len(tt.want) {
+			t.Errorf("%s: set = %v (size %d), want %v", tt.name, got, set.Size(), tt.want)
+		}
+	}
+
+	// 替换后重新排序，相同的元素只保留一个
+	set := of(1, 2, 3, 4, 5)
+	set.ReplaceAll(func(item int) int { return -(item / 2) })
+	if got, want := set.ToSlice(), []int{-2, -1, 0}; !slices.Equal(got, want) {
+		t.Errorf("after ReplaceAll: set = %v, want %v", got, want)
+	}
+
+	set = of(1, 2, 3)
+	if !set.ContainsAll(of(3, 1)) || !set.ContainsAll(of()) || set.ContainsAll(of(1, 4)) {
+		t.Error("ContainsAll disagrees with Contains")
+	}
+	empty := of()
+	if empty.RemoveIf(func(int) bool { return true }) || empty.RemoveAll(empty) || empty.RetainAll(of(1)) {
+		t.Error("bulk operations on an empty set reported a change")
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	set := NewOrderedTreeSet[int]()
+	for _, f := range []func(){func() { set.First() }, func() { set.Last() }} {
+		func() {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, collection.ErrNoSuchElement) {
+					t.Errorf("panic = %v, want ErrNoSuchElement", err)
+				}
+			}()
+			f()
+		}()
+	}
+	if _, err := set.TryFirst(); !errors.Is(err, collection.ErrNoSuchElement) {
+		t.Errorf("TryFirst error = %v, want ErrNoSuchElement", err)
+	}
+	if set.FirstOpt().IsPresent() || set.LastOpt().IsPresent() {
+		t.Error("FirstOpt/LastOpt on an empty set are present")
+	}
+
+	set.AddAll(3, -1, 2)
+	if set.First() != -1 || set.Last() != 3 {
+		t.Errorf("First, Last = %d, %d, want -1, 3", set.First(), set.Last())
+	}
+	if v, err := set.TryLast(); err != nil || v != 3 {
+		t.Errorf("TryLast = %d, %v, want 3", v, err)
+	}
+	if set.FirstOpt().Get() != -1 || set.LastOpt().Get() != 3 {
+		t.Error("FirstOpt/LastOpt disagree with First/Last")
+	}
+}
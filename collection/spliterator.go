@@ -0,0 +1,39 @@
+package collection
+
+// Characteristics 是 Spliterator 特征值的位集合，对应 Java Spliterator 的 ORDERED、DISTINCT 等常量。
+type Characteristics int
+
+const (
+	// Ordered 表示元素有确定的遭遇顺序，拆分出的前缀在顺序上位于剩余部分之前。
+	Ordered Characteristics = 1 << iota
+	// Distinct 表示元素两两不相等。
+	Distinct
+	// Sorted 表示元素按某种比较顺序排列。
+	Sorted
+	// Sized 表示 EstimateSize 返回的是精确的元素数量。
+	Sized
+	// Subsized 表示拆分出的所有 Spliterator 都是 Sized 的。
+	Subsized
+)
+
+// Has 检查是否包含全部指定的特征。
+func (c Characteristics) Has(flags Characteristics) bool {
+	return c&flags == flags
+}
+
+// Spliterator 是可拆分的迭代器，并行流通过它把数据源切分给多个worker，对应 Java 的 java.util.Spliterator。
+//
+// 同一个 Spliterator 只能被一个goroutine使用；拆分出的部分可以交给其他goroutine并发遍历，
+// 前提是遍历期间没有任何goroutine修改底层集合。
+type Spliterator[T any] interface {
+	// TryAdvance 若还有剩余元素，则对下一个元素执行 action 并返回true，否则返回false。
+	TryAdvance(action func(T)) bool
+	// ForEachRemaining 对所有剩余元素依次执行 action。
+	ForEachRemaining(action func(T))
+	// TrySplit 将剩余元素的前一部分拆分为新的 Spliterator 返回，无法拆分时返回nil。
+	TrySplit() Spliterator[T]
+	// EstimateSize 返回剩余元素数量的估计值，带有 Sized 特征时为精确值。
+	EstimateSize() int
+	// Characteristics 返回该 Spliterator 的特征。
+	Characteristics() Characteristics
+}
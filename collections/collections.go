@@ -0,0 +1,247 @@
+package collections
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/list/arraylist"
+	"github.com/herry-hu/go-collections-java/lang"
+	"math/rand/v2"
+	"slices"
+)
+
+// Sort 按元素的自然顺序（lang.Comparable[T]）对列表进行稳定排序。
+func Sort[T lang.Comparable[T]](list collection.List[T]) {
+	SortFunc(list, compareNatural[T])
+}
+
+// SortFunc 按比较函数对列表进行稳定排序，cmp 的约定与 slices.SortFunc 相同。
+//
+// 列表自身提供 Sort 方法时（ArrayList 的 TimSort、链表的归并排序）直接原地排序，否则复制为切片排序后写回。
+func SortFunc[T any](list collection.List[T], cmp func(a, b T) int) {
+	if sortable, ok := list.(interface{ Sort(cmp func(a, b T) int) }); ok {
+		sortable.Sort(cmp)
+		return
+	}
+	items := list.ToSlice()
+	slices.SortStableFunc(items, cmp)
+	setAll(list, items)
+}
+
+// BinarySearch 在按自然顺序升序排列的列表中二分查找 key。
+//
+// 找到时返回其索引；否则返回 -(插入点)-1，插入点是第一个大于 key 的元素的索引，因此返回值 >= 0 当且仅当找到。
+// 链表按索引访问的开销是O(n)，在链表上的复杂度为O(n log n)。
+func BinarySearch[T lang.Comparable[T]](list collection.List[T], key T) int {
+	return BinarySearchFunc(list, key, compareNatural[T])
+}
+
+// BinarySearchFunc 在按比较函数升序排列的列表中二分查找 key，返回值的约定与 BinarySearch 相同。
+func BinarySearchFunc[T any](list collection.List[T], key T, cmp func(a, b T) int) int {
+	low, high := 0, list.Size()-1
+	for low <= high {
+		mid := int(uint(low+high) >> 1)
+		switch c := cmp(list.Get(mid), key); {
+		case c < 0:
+			low = mid + 1
+		case c > 0:
+			high = mid - 1
+		default:
+			return mid
+		}
+	}
+	return -(low + 1)
+}
+
+// Reverse 反转列表中元素的顺序。
+func Reverse[T any](list collection.List[T]) {
+	items := list.ToSlice()
+	slices.Reverse(items)
+	setAll(list, items)
+}
+
+// Shuffle 使用 rnd 随机打乱列表中的元素，传入相同种子的随机源可以得到可复现的结果；rnd 为nil时使用全局随机源。
+func Shuffle[T any](list collection.List[T], rnd *rand.Rand) {
+	items := list.ToSlice()
+	swap := func(i, j int) { items[i], items[j] = items[j], items[i] }
+	if rnd == nil {
+		rand.Shuffle(len(items), swap)
+	} else {
+		rnd.Shuffle(len(items), swap)
+	}
+	setAll(list, items)
+}
+
+// Rotate 将列表中的元素向后循环移动 distance 个位置，distance 可以为负数或大于列表大小。
+//
+// 例如对 [t, a, n, k, s] 执行 Rotate(list, 1) 得到 [s, t, a, n, k]。
+func Rotate[T any](list collection.List[T], distance int) {
+	size := list.Size()
+	if size == 0 {
+		return
+	}
+	distance %= size
+	if distance < 0 {
+		distance += size
+	}
+	if distance == 0 {
+		return
+	}
+	items := list.ToSlice()
+	setAll(list, append(items[size-distance:], items[:size-distance]...))
+}
+
+// Swap 交换列表中两个位置的元素。
+func Swap[T any](list collection.List[T], i, j int) {
+	item := list.Get(i)
+	list.Set(i, list.Get(j))
+	list.Set(j, item)
+}
+
+// Fill 将列表中的所有元素替换为 item。
+func Fill[T any](list collection.List[T], item T) {
+	items := make([]T, list.Size())
+	for i := range items {
+		items[i] = item
+	}
+	setAll(list, items)
+}
+
+// Copy 将 src 的所有元素复制到 dest 的相同位置，dest 中多出的元素保持不变；dest 比 src 短时以 collection.ErrIndexOutOfBounds panic。
+func Copy[T any](dest, src collection.List[T]) {
+	if src.Size() > dest.Size() {
+		panic(fmt.Errorf("%w: source does not fit in dest", collection.ErrIndexOutOfBounds))
+	}
+	items := dest.ToSlice()
+	copy(items, src.ToSlice())
+	setAll(dest, items)
+}
+
+// Frequency 返回集合中等于 item 的元素个数。
+func Frequency[T comparable](c collection.Collection[T], item T) int {
+	count := 0
+	for v := range c.All() {
+		if collection.Equal(v, item) {
+			count++
+		}
+	}
+	return count
+}
+
+// Disjoint 检查两个集合是否没有公共元素。
+func Disjoint[T comparable](c1, c2 collection.Collection[T]) bool {
+	// 遍历较小的集合，在另一个集合中查找；Set 的 Contains 更快，优先作为被查找的一方
+	_, set1 := c1.(collection.Set[T])
+	_, set2 := c2.(collection.Set[T])
+	if set1 && !set2 || !set1 && !set2 && c1.Size() > c2.Size() {
+		c1, c2 = c2, c1
+	}
+	for v := range c1.All() {
+		if c2.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// NCopies 返回由 n 个 item 组成的列表，n 为负数时以 collection.ErrIllegalArgument panic。
+func NCopies[T comparable](n int, item T) collection.List[T] {
+	if n < 0 {
+		panic(fmt.Errorf("%w: negative count %d", collection.ErrIllegalArgument, n))
+	}
+	list := arraylist.NewArrayListWithCapacity[T](n)
+	for i := 0; i < n; i++ {
+		list.Add(item)
+	}
+	return list
+}
+
+// Min 返回集合中按自然顺序最小的元素，集合为空时panic。
+func Min[T lang.Comparable[T]](c collection.Collection[T]) T {
+	return MinFunc(c, compareNatural[T])
+}
+
+// MinFunc 返回集合中按比较函数最小的元素，有多个时返回第一个，集合为空时panic。
+func MinFunc[T any](c collection.Collection[T], cmp func(a, b T) int) T {
+	return extreme(c, func(a, b T) bool { return cmp(a, b) < 0 })
+}
+
+// Max 返回集合中按自然顺序最大的元素，集合为空时panic。
+func Max[T lang.Comparable[T]](c collection.Collection[T]) T {
+	return MaxFunc(c, compareNatural[T])
+}
+
+// MaxFunc 返回集合中按比较函数最大的元素，有多个时返回第一个，集合为空时panic。
+func MaxFunc[T any](c collection.Collection[T], cmp func(a, b T) int) T {
+	return extreme(c, func(a, b T) bool { return cmp(a, b) > 0 })
+}
+
+// IndexOfSubList 返回 target 在 source 中第一次出现的起始位置，不存在时返回-1；target 为空时返回0。
+func IndexOfSubList[T comparable](source, target collection.List[T]) int {
+	src, tgt := source.ToSlice(), target.ToSlice()
+	for i := 0; i+len(tgt) <= len(src); i++ {
+		if slices.EqualFunc(src[i:i+len(tgt)], tgt, collection.Equal[T]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOfSubList 返回 target 在 source 中最后一次出现的起始位置，不存在时返回-1；target 为空时返回 source 的大小。
+func LastIndexOfSubList[T comparable](source, target collection.List[T]) int {
+	src, tgt := source.ToSlice(), target.ToSlice()
+	for i := len(src) - len(tgt); i >= 0; i-- {
+		if slices.EqualFunc(src[i:i+len(tgt)], tgt, collection.Equal[T]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReplaceAll 将列表中所有等于 oldVal 的元素替换为 newVal，至少替换了一个元素时返回true。
+func ReplaceAll[T comparable](list collection.List[T], oldVal, newVal T) bool {
+	items := list.ToSlice()
+	replaced := false
+	for i, v := range items {
+		if collection.Equal(v, oldVal) {
+			items[i] = newVal
+			replaced = true
+		}
+	}
+	if replaced {
+		setAll(list, items)
+	}
+	return replaced
+}
+
+// compareNatural 按 lang.Comparable[T] 的自然顺序比较两个元素。
+func compareNatural[T lang.Comparable[T]](a, b T) int {
+	return a.CompareTo(b)
+}
+
+// extreme 返回集合中使 better(候选, 当前) 成立的最靠前的极值元素，集合为空时panic。
+func extreme[T any](c collection.Collection[T], better func(a, b T) bool) T {
+	var result T
+	found := false
+	for v := range c.All() {
+		if !found || better(v, result) {
+			result = v
+			found = true
+		}
+	}
+	if !found {
+		panic(collection.ErrNoSuchElement)
+	}
+	return result
+}
+
+// setAll 按顺序把 items 写回列表，不改变列表的大小。
+//
+// 通过 ReplaceAll 一次遍历写回，链表上也是O(n)，而不是每个下标调用一次 Set 的O(n²)。
+func setAll[T any](list collection.List[T], items []T) {
+	i := 0
+	list.ReplaceAll(func(T) T {
+		item := items[i]
+		i++
+		return item
+	})
+}
@@ -0,0 +1,167 @@
+package collections
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/list/arraylist"
+	"github.com/herry-hu/go-collections-java/collection/list/linkedlist"
+	"github.com/herry-hu/go-collections-java/collection/set/hashset"
+	"github.com/herry-hu/go-collections-java/lang"
+)
+
+// listOf 返回包含 items 的 ArrayList。
+func listOf[T comparable](items ...T) *arraylist.ArrayList[T] {
+	list := arraylist.NewArrayList[T]()
+	list.AddAll(items...)
+	return list
+}
+
+// setOf 返回包含 items 的 HashSet。
+func setOf[T comparable](items ...T) *hashset.HashSet[T] {
+	set := hashset.NewHashSet[T]()
+	set.AddAll(items...)
+	return set
+}
+
+// expectPanic 检查 f 以包装了 target 的错误panic。
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, target) {
+			t.Fatalf("panic = %v, want %v", err, target)
+		}
+	}()
+	f()
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		distance int
+		want     []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{1, []int{5, 1, 2, 3, 4}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{6, []int{5, 1, 2, 3, 4}},
+		{-7, []int{3, 4, 5, 1, 2}},
+		{12, []int{4, 5, 1, 2, 3}},
+		{-10, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		// 在 ArrayList 与链表上结果相同
+		lists := []collection.List[int]{listOf(1, 2, 3, 4, 5), linkedlist.NewLinkedList[int]()}
+		lists[1].AddAll(1, 2, 3, 4, 5)
+		for _, list := range lists {
+			Rotate(list, tt.distance)
+			if got := list.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("Rotate(%T, %d) = %v, want %v", list, tt.distance, got, tt.want)
+			}
+		}
+	}
+
+	empty := listOf[int]()
+	Rotate(empty, 3)
+	Rotate(empty, -3)
+	if !empty.IsEmpty() {
+		t.Error("Rotate changed an empty list")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	list := listOf[lang.Int](10, 20, 30, 40)
+	tests := []struct {
+		key  lang.Int
+		want int
+	}{
+		{10, 0},
+		{30, 2},
+		{40, 3},
+		{5, -1},  // 插入点0
+		{15, -2}, // 插入点1
+		{35, -4}, // 插入点3
+		{45, -5}, // 插入点为列表大小
+	}
+	for _, tt := range tests {
+		got := BinarySearch(list, tt.key)
+		if got != tt.want {
+			t.Errorf("BinarySearch(%d) = %d, want %d", tt.key, got, tt.want)
+		}
+		// 在插入点插入 key 后列表仍然有序
+		if got < 0 {
+			items := slices.Insert(list.ToSlice(), -got-1, tt.key)
+			if !slices.IsSorted(items) {
+				t.Errorf("inserting %d at %d gives unsorted %v", tt.key, -got-1, items)
+			}
+		}
+	}
+	if got := BinarySearch(listOf[lang.Int](), 1); got != -1 {
+		t.Errorf("BinarySearch on an empty list = %d, want -1", got)
+	}
+	// 按比较函数降序排列的列表
+	desc := listOf(9, 7, 5, 3)
+	reverse := func(a, b int) int { return cmp.Compare(b, a) }
+	if got := BinarySearchFunc(desc, 6, reverse); got != -3 {
+		t.Errorf("BinarySearchFunc(6) = %d, want -3", got)
+	}
+	if got := BinarySearchFunc(desc, 3, reverse); got != 3 {
+		t.Errorf("BinarySearchFunc(3) = %d, want 3", got)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	dest := listOf(0, 0, 0, 0, 9)
+	Copy(dest, listOf(1, 2, 3))
+	if got, want := dest.ToSlice(), []int{1, 2, 3, 0, 9}; !slices.Equal(got, want) {
+		t.Errorf("Copy into a longer list = %v, want %v", got, want)
+	}
+	Copy(dest, listOf(5, 4, 3, 2, 1))
+	if got, want := dest.ToSlice(), []int{5, 4, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("Copy into a list of the same size = %v, want %v", got, want)
+	}
+
+	short := listOf(7, 8)
+	expectPanic(t, collection.ErrIndexOutOfBounds, func() { Copy(short, listOf(1, 2, 3)) })
+	if got, want := short.ToSlice(), []int{7, 8}; !slices.Equal(got, want) {
+		t.Errorf("Copy modified the destination before panicking: %v", got)
+	}
+}
+
+func TestDisjoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		c1, c2 collection.Collection[int]
+		want   bool
+	}{
+		{"list and list", listOf(1, 2, 3), listOf(4, 5), true},
+		{"list and list overlapping", listOf(1, 2, 3), listOf(5, 3), false},
+		{"list and set", listOf(1, 2, 3), setOf(4, 5, 6, 7), true},
+		{"list and set overlapping", listOf(1, 2, 3, 4, 5, 6), setOf(6), false},
+		{"set and list", setOf(1, 2, 3), listOf(4, 5), true},
+		{"set and list overlapping", setOf(9), listOf(1, 2, 3, 9), false},
+		{"set and set", setOf(1, 2), setOf(3, 4, 5), true},
+		{"set and set overlapping", setOf(1, 2), setOf(3, 2), false},
+		{"empty list", listOf[int](), setOf(1), true},
+		{"both empty", setOf[int](), listOf[int](), true},
+	}
+	for _, tt := range tests {
+		if got := Disjoint(tt.c1, tt.c2); got != tt.want {
+			t.Errorf("%s: Disjoint = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := Disjoint(tt.c2, tt.c1); got != tt.want {
+			t.Errorf("%s (swapped): Disjoint = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMinMaxEmpty(t *testing.T) {
+	expectPanic(t, collection.ErrNoSuchElement, func() { Min(listOf[lang.Int]()) })
+	expectPanic(t, collection.ErrNoSuchElement, func() { MaxFunc(listOf[int](), cmp.Compare[int]) })
+	expectPanic(t, collection.ErrIllegalArgument, func() { NCopies(-1, 0) })
+}
@@ -0,0 +1,585 @@
+package collections
+
+import (
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// SynchronizedList 返回由读写锁保护的 list，之后应只通过返回值访问 list。
+func SynchronizedList[T any](list collection.List[T]) *SyncList[T] {
+	return &SyncList[T]{syncCollection: syncCollection[T]{c: list}, list: list}
+}
+
+// SynchronizedSet 返回由读写锁保护的 set，之后应只通过返回值访问 set。
+func SynchronizedSet[T any](set collection.Set[T]) *SyncSet[T] {
+	return &SyncSet[T]{syncCollection: syncCollection[T]{c: set}, set: set}
+}
+
+// SynchronizedSortedSet 返回由读写锁保护的 set，之后应只通过返回值访问 set。
+func SynchronizedSortedSet[T any](set collection.SortedSet[T]) *SyncSortedSet[T] {
+	return &SyncSortedSet[T]{SyncSet: SyncSet[T]{syncCollection: syncCollection[T]{c: set}, set: set}, sorted: set}
+}
+
+// SynchronizedMap 返回由读写锁保护的 m，之后应只通过返回值访问 m。
+func SynchronizedMap[K comparable, V any](m collection.Map[K, V]) *SyncMap[K, V] {
+	return &SyncMap[K, V]{m: m}
+}
+
+// syncCollection 实现所有单值集合装饰器共有的方法，读操作持有读锁，写操作持有写锁。
+type syncCollection[T any] struct {
+	c    collection.Collection[T]
+	lock sync.RWMutex
+}
+
+func (s *syncCollection[T]) Add(item T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.c.Add(item)
+}
+
+func (s *syncCollection[T]) AddAll(items ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.c.AddAll(items...)
+}
+
+func (s *syncCollection[T]) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.c.Clear()
+}
+
+func (s *syncCollection[T]) Contains(item T) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.c.Contains(item)
+}
+
+// ContainsAll 先在不持有锁的情况下读取 c 的所有元素，因此 c 可以是装饰器本身。
+func (s *syncCollection[T]) ContainsAll(c collection.Collection[T]) bool {
+	items := slices.Collect(c.All())
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, item := range items {
+		if !s.c.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveIf 持有写锁删除所有满足 filter 的元素，filter 不能调用装饰器的方法，否则会死锁。
+func (s *syncCollection[T]) RemoveIf(filter func(item T) bool) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.c.RemoveIf(filter)
+}
+
+// RemoveAll 持有写锁期间会调用 c.Contains，因此 c 不能是装饰器本身。
+func (s *syncCollection[T]) RemoveAll(c collection.Collection[T]) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.c.RemoveAll(c)
+}
+
+// RetainAll 持有写锁期间会调用 c.Contains，因此 c 不能是装饰器本身。
+func (s *syncCollection[T]) RetainAll(c collection.Collection[T]) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.c.RetainAll(c)
+}
+
+func (s *syncCollection[T]) Size() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.c.Size()
+}
+
+func (s *syncCollection[T]) IsEmpty() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.c.IsEmpty()
+}
+
+func (s *syncCollection[T]) ToSlice() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.c.ToSlice()
+}
+
+// All 返回产出所有元素的序列，序列遍历的是开始遍历时的元素快照，遍历中可以安全地修改集合。
+func (s *syncCollection[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.ToSlice() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *syncCollection[T]) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.c.String()
+}
+
+// SyncList 是由读写锁保护的 List 装饰器，所有方法都可以被多个goroutine并发调用。
+type SyncList[T any] struct {
+	syncCollection[T]
+	list collection.List[T]
+}
+
+var _ collection.List[int] = (*SyncList[int])(nil)
+
+// WithLock 持有写锁执行 fn，用于需要原子完成的复合操作；fn 应直接操作传入的列表，不能再调用 SyncList 的方法，否则会死锁。
+func (s *SyncList[T]) WithLock(fn func(list collection.List[T])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fn(s.list)
+}
+
+// Iterator 返回遍历创建时元素快照的迭代器；按索引删除在快照上没有意义，迭代器的 Remove 以 collection.ErrUnsupportedOperation panic，
+// 需要边遍历边删除时请在 WithLock 中使用原列表的迭代器。
+func (s *SyncList[T]) Iterator() collection.Iterator[T] {
+	return newSnapshotIterator(s.ToSlice(), identity[T], nil)
+}
+
+func (s *SyncList[T]) Get(index int) T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.Get(index)
+}
+
+func (s *SyncList[T]) Set(index int, item T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list.Set(index, item)
+}
+
+func (s *SyncList[T]) Remove(index int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list.Remove(index)
+}
+
+func (s *SyncList[T]) TryGet(index int) (T, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.TryGet(index)
+}
+
+func (s *SyncList[T]) TrySet(index int, item T) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.list.TrySet(index, item)
+}
+
+func (s *SyncList[T]) TryRemove(index int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.list.TryRemove(index)
+}
+
+// Sort 持有写锁对被包装的列表进行稳定排序，排序期间其他goroutine看不到中间状态；cmp 不能调用装饰器的方法，否则会死锁。
+//
+// SortFunc 遇到 SyncList 时会调用这个方法，而不是逐个下标地调用 Set 写回。
+func (s *SyncList[T]) Sort(cmp func(a, b T) int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	SortFunc(s.list, cmp)
+}
+
+// ReplaceAll 持有写锁替换所有元素，operator 不能调用装饰器的方法，否则会死锁。
+func (s *SyncList[T]) ReplaceAll(operator func(item T) T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list.ReplaceAll(operator)
+}
+
+// Equals 持有读锁比较，other 是装饰器本身时直接返回true。
+func (s *SyncList[T]) Equals(other collection.List[T]) bool {
+	if any(other) == any(s) {
+		return true
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.Equals(other)
+}
+
+func (s *SyncList[T]) HashCode() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.HashCode()
+}
+
+func (s *SyncList[T]) IndexOf(item T) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.IndexOf(item)
+}
+
+func (s *SyncList[T]) LastIndexOf(item T) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list.LastIndexOf(item)
+}
+
+// Backward 返回从尾到头产出所有元素的序列，遍历的是开始遍历时的元素快照。
+func (s *SyncList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		items := s.ToSlice()
+		for i := len(items) - 1; i >= 0; i-- {
+			if !yield(items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// SyncSet 是由读写锁保护的 Set 装饰器，所有方法都可以被多个goroutine并发调用。
+type SyncSet[T any] struct {
+	syncCollection[T]
+	set collection.Set[T]
+}
+
+var _ collection.Set[int] = (*SyncSet[int])(nil)
+
+// WithLock 持有写锁执行 fn，用于需要原子完成的复合操作；fn 应直接操作传入的集合，不能再调用 SyncSet 的方法，否则会死锁。
+func (s *SyncSet[T]) WithLock(fn func(set collection.Set[T])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fn(s.set)
+}
+
+// Iterator 返回遍历创建时元素快照的迭代器，迭代器的 Remove 从集合中删除对应的元素。
+func (s *SyncSet[T]) Iterator() collection.Iterator[T] {
+	return newSnapshotIterator(s.ToSlice(), identity[T], func(item T) { s.Remove(item) })
+}
+
+// Equals 持有读锁比较，other 是装饰器本身时直接返回true。
+func (s *SyncSet[T]) Equals(other collection.Set[T]) bool {
+	if any(other) == any(s) {
+		return true
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.set.Equals(other)
+}
+
+func (s *SyncSet[T]) HashCode() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.set.HashCode()
+}
+
+func (s *SyncSet[T]) Remove(item T) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.set.Remove(item)
+}
+
+// SyncSortedSet 是由读写锁保护的 SortedSet 装饰器，所有方法都可以被多个goroutine并发调用。
+type SyncSortedSet[T any] struct {
+	SyncSet[T]
+	sorted collection.SortedSet[T]
+}
+
+var _ collection.SortedSet[int] = (*SyncSortedSet[int])(nil)
+
+// WithLock 持有写锁执行 fn，用于需要原子完成的复合操作；fn 应直接操作传入的集合，不能再调用 SyncSortedSet 的方法，否则会死锁。
+func (s *SyncSortedSet[T]) WithLock(fn func(set collection.SortedSet[T])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fn(s.sorted)
+}
+
+// Equals 持有读锁比较，other 是装饰器本身时直接返回true。
+func (s *SyncSortedSet[T]) Equals(other collection.Set[T]) bool {
+	if any(other) == any(s) {
+		return true
+	}
+	return s.SyncSet.Equals(other)
+}
+
+func (s *SyncSortedSet[T]) First() T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sorted.First()
+}
+
+func (s *SyncSortedSet[T]) Last() T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sorted.Last()
+}
+
+func (s *SyncSortedSet[T]) TryFirst() (T, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sorted.TryFirst()
+}
+
+func (s *SyncSortedSet[T]) TryLast() (T, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sorted.TryLast()
+}
+
+// Backward 返回按降序产出所有元素的序列，遍历的是开始遍历时的元素快照。
+func (s *SyncSortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		items := s.ToSlice()
+		for i := len(items) - 1; i >= 0; i-- {
+			if !yield(items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// SyncMap 是由读写锁保护的 Map 装饰器，所有方法都可以被多个goroutine并发调用。
+type SyncMap[K comparable, V any] struct {
+	m    collection.Map[K, V]
+	lock sync.RWMutex
+}
+
+var _ collection.Map[int, int] = (*SyncMap[int, int])(nil)
+
+// WithLock 持有写锁执行 fn，用于需要原子完成的复合操作；fn 应直接操作传入的映射，不能再调用 SyncMap 的方法，否则会死锁。
+func (s *SyncMap[K, V]) WithLock(fn func(m collection.Map[K, V])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fn(s.m)
+}
+
+// Equals 持有读锁比较，other 是装饰器本身时直接返回true。
+func (s *SyncMap[K, V]) Equals(other collection.Map[K, V]) bool {
+	if any(other) == any(s) {
+		return true
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.Equals(other)
+}
+
+func (s *SyncMap[K, V]) HashCode() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.HashCode()
+}
+
+func (s *SyncMap[K, V]) Put(key K, value V) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.m.Put(key, value)
+}
+
+func (s *SyncMap[K, V]) Get(key K) (V, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.Get(key)
+}
+
+func (s *SyncMap[K, V]) TryGet(key K) (V, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.TryGet(key)
+}
+
+func (s *SyncMap[K, V]) Delete(key K) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.m.Delete(key)
+}
+
+func (s *SyncMap[K, V]) ContainsKey(key K) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.ContainsKey(key)
+}
+
+func (s *SyncMap[K, V]) Size() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.Size()
+}
+
+func (s *SyncMap[K, V]) IsEmpty() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.IsEmpty()
+}
+
+func (s *SyncMap[K, V]) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.m.Clear()
+}
+
+// ForEach 对开始遍历时的每个键值对执行 fn，fn 中可以安全地修改映射。
+func (s *SyncMap[K, V]) ForEach(fn func(key K, value V)) {
+	for _, e := range s.entries() {
+		fn(e.Key, e.Value)
+	}
+}
+
+// KeyIterator 返回遍历创建时键快照的迭代器，迭代器的 Remove 从映射中删除对应的键值对。
+func (s *SyncMap[K, V]) KeyIterator() collection.Iterator[K] {
+	return newSnapshotIterator(s.entries(), func(e collection.Entry[K, V]) K { return e.Key }, s.deleteEntry)
+}
+
+// ValueIterator 返回遍历创建时值快照的迭代器，迭代器的 Remove 从映射中删除对应的键值对。
+func (s *SyncMap[K, V]) ValueIterator() collection.Iterator[V] {
+	return newSnapshotIterator(s.entries(), func(e collection.Entry[K, V]) V { return e.Value }, s.deleteEntry)
+}
+
+// EntryIterator 返回遍历创建时键值对快照的迭代器，迭代器的 Remove 从映射中删除对应的键值对。
+func (s *SyncMap[K, V]) EntryIterator() collection.Iterator[collection.Entry[K, V]] {
+	return newSnapshotIterator(s.entries(), identity[collection.Entry[K, V]], s.deleteEntry)
+}
+
+// All 返回产出所有键值对的序列，序列遍历的是开始遍历时的快照，遍历中可以安全地修改映射。
+func (s *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range s.entries() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys 返回产出所有键的序列，遍历的是开始遍历时的快照。
+func (s *SyncMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, e := range s.entries() {
+			if !yield(e.Key) {
+				return
+			}
+		}
+	}
+}
+
+// Values 返回产出所有值的序列，遍历的是开始遍历时的快照。
+func (s *SyncMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, e := range s.entries() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SyncMap[K, V]) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.m.String()
+}
+
+// entries 持有读锁复制所有键值对。
+func (s *SyncMap[K, V]) entries() []collection.Entry[K, V] {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	entries := make([]collection.Entry[K, V], 0, s.m.Size())
+	for k, v := range s.m.All() {
+		entries = append(entries, collection.Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func (s *SyncMap[K, V]) deleteEntry(e collection.Entry[K, V]) {
+	s.Delete(e.Key)
+}
+
+// snapshotIterator 遍历一份快照，Remove 通过 remove 回调作用到原容器，remove 为nil时不支持删除。
+type snapshotIterator[E any, R any] struct {
+	items   []E          // 创建迭代器时的快照
+	project func(E) R    // 将快照中的元素转换为迭代器产出的值
+	remove  func(item E) // 从原容器中删除快照中的元素
+	cursor  int          // 下一次 Next 返回的元素下标
+	last    int          // 最近一次 Next 返回的元素下标
+}
+
+func newSnapshotIterator[E any, R any](items []E, project func(E) R, remove func(E)) *snapshotIterator[E, R] {
+	return &snapshotIterator[E, R]{items: items, project: project, remove: remove, last: -1}
+}
+
+func (it *snapshotIterator[E, R]) HasNext() bool {
+	return it.cursor < len(it.items)
+}
+
+func (it *snapshotIterator[E, R]) Next() R {
+	if !it.HasNext() {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.last = it.cursor
+	it.cursor++
+	return it.project(it.items[it.last])
+}
+
+func (it *snapshotIterator[E, R]) Remove() {
+	if it.remove == nil {
+		panic(collection.ErrUnsupportedOperation)
+	}
+	if it.last < 0 {
+		panic(collection.ErrIllegalState)
+	}
+	it.remove(it.items[it.last])
+	it.last = -1
+}
+
+func identity[T any](item T) T {
+	return item
+}
@@ -0,0 +1,106 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/list/linkedlist"
+	"github.com/herry-hu/go-collections-java/map/hashmap"
+)
+
+// 排序在写锁内完成，并发的读者只能看到完全升序或完全降序的列表。运行 go test -race 时还会检查数据竞争。
+func TestSyncListSortIsAtomic(t *testing.T) {
+	const n = 500
+	inner := linkedlist.NewLinkedList[int]()
+	for i := 0; i < n; i++ {
+		inner.AddAt(0, i)
+	}
+	list := SynchronizedList[int](inner)
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				items := list.ToSlice()
+				if len(items) != n {
+					t.Errorf("reader saw %d elements, want %d", len(items), n)
+					return
+				}
+				ascending := slices.IsSorted(items)
+				descending := slices.IsSortedFunc(items, func(a, b int) int { return cmp.Compare(b, a) })
+				if !ascending && !descending {
+					t.Error("reader saw a partially sorted list")
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		if i%2 == 0 {
+			SortFunc(list, cmp.Compare[int])
+		} else {
+			list.Sort(func(a, b int) int { return cmp.Compare(b, a) })
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+// WithLock 中的检查与添加是一个原子操作，并发执行也不会添加重复的元素。
+func TestSyncListWithLock(t *testing.T) {
+	list := SynchronizedList[int](listOf[int]())
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				list.WithLock(func(l collection.List[int]) {
+					if !l.Contains(i) {
+						l.Add(i)
+					}
+				})
+				list.Get(0)
+			}
+		}()
+	}
+	wg.Wait()
+
+	items := list.ToSlice()
+	slices.Sort(items)
+	if len(items) != 100 || items[0] != 0 || items[99] != 99 {
+		t.Fatalf("list has %d elements after concurrent WithLock, want 0..99 once each", len(items))
+	}
+}
+
+func TestSyncMapWithLock(t *testing.T) {
+	m := SynchronizedMap[string, int](hashmap.NewHashMap[string, int]())
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				m.WithLock(func(m collection.Map[string, int]) {
+					v, _ := m.Get("count")
+					m.Put("count", v+1)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	if v, _ := m.Get("count"); v != 8000 {
+		t.Fatalf("count = %d, want 8000", v)
+	}
+}
@@ -0,0 +1,314 @@
+package collections
+
+import (
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
+)
+
+// UnmodifiableOption 配置不可修改视图拒绝写操作的方式。
+type UnmodifiableOption func(g *guard)
+
+// OnMutation 指定视图拒绝写操作时调用的函数，参数为 collection.ErrUnsupportedOperation。
+//
+// 默认直接以该错误panic；指定的函数正常返回时写操作被忽略，有返回值的写操作返回false或零值。
+func OnMutation(handler func(err error)) UnmodifiableOption {
+	return func(g *guard) {
+		g.handler = handler
+	}
+}
+
+// guard 负责拒绝视图上的写操作。
+type guard struct {
+	handler func(err error)
+}
+
+func newGuard(opts []UnmodifiableOption) guard {
+	g := guard{handler: func(err error) { panic(err) }}
+	for _, opt := range opts {
+		opt(&g)
+	}
+	return g
+}
+
+func (g guard) reject() {
+	g.handler(collection.ErrUnsupportedOperation)
+}
+
+// UnmodifiableList 返回 list 的只读视图，读操作直接转发给 list，因此能反映 list 之后的修改；任何写操作都会被拒绝。
+func UnmodifiableList[T any](list collection.List[T], opts ...UnmodifiableOption) collection.List[T] {
+	return &unmodifiableList[T]{unmodifiableCollection[T]{list, newGuard(opts)}, list}
+}
+
+// UnmodifiableSet 返回 set 的只读视图，语义与 UnmodifiableList 相同。
+func UnmodifiableSet[T any](set collection.Set[T], opts ...UnmodifiableOption) collection.Set[T] {
+	return &unmodifiableSet[T]{unmodifiableCollection[T]{set, newGuard(opts)}, set}
+}
+
+// UnmodifiableSortedSet 返回 set 的只读视图，语义与 UnmodifiableList 相同。
+func UnmodifiableSortedSet[T any](set collection.SortedSet[T], opts ...UnmodifiableOption) collection.SortedSet[T] {
+	return &unmodifiableSortedSet[T]{unmodifiableSet[T]{unmodifiableCollection[T]{set, newGuard(opts)}, set}, set}
+}
+
+// UnmodifiableMap 返回 m 的只读视图，语义与 UnmodifiableList 相同。
+func UnmodifiableMap[K comparable, V any](m collection.Map[K, V], opts ...UnmodifiableOption) collection.Map[K, V] {
+	return &unmodifiableMap[K, V]{m, newGuard(opts)}
+}
+
+// unmodifiableCollection 实现所有单值集合视图共有的方法。
+type unmodifiableCollection[T any] struct {
+	c collection.Collection[T]
+	guard
+}
+
+func (u *unmodifiableCollection[T]) Add(item T) {
+	u.reject()
+}
+
+func (u *unmodifiableCollection[T]) AddAll(items ...T) {
+	u.reject()
+}
+
+func (u *unmodifiableCollection[T]) Clear() {
+	u.reject()
+}
+
+func (u *unmodifiableCollection[T]) Contains(item T) bool {
+	return u.c.Contains(item)
+}
+
+func (u *unmodifiableCollection[T]) ContainsAll(c collection.Collection[T]) bool {
+	return u.c.ContainsAll(c)
+}
+
+func (u *unmodifiableCollection[T]) RemoveIf(filter func(item T) bool) bool {
+	u.reject()
+	return false
+}
+
+func (u *unmodifiableCollection[T]) RemoveAll(c collection.Collection[T]) bool {
+	u.reject()
+	return false
+}
+
+func (u *unmodifiableCollection[T]) RetainAll(c collection.Collection[T]) bool {
+	u.reject()
+	return false
+}
+
+func (u *unmodifiableCollection[T]) Size() int {
+	return u.c.Size()
+}
+
+func (u *unmodifiableCollection[T]) IsEmpty() bool {
+	return u.c.IsEmpty()
+}
+
+func (u *unmodifiableCollection[T]) ToSlice() []T {
+	return u.c.ToSlice()
+}
+
+func (u *unmodifiableCollection[T]) Iterator() collection.Iterator[T] {
+	return &unmodifiableIterator[T]{u.c.Iterator(), u.guard}
+}
+
+func (u *unmodifiableCollection[T]) All() iter.Seq[T] {
+	return u.c.All()
+}
+
+func (u *unmodifiableCollection[T]) String() string {
+	return u.c.String()
+}
+
+type unmodifiableList[T any] struct {
+	unmodifiableCollection[T]
+	list collection.List[T]
+}
+
+func (u *unmodifiableList[T]) Get(index int) T {
+	return u.list.Get(index)
+}
+
+func (u *unmodifiableList[T]) Set(index int, item T) {
+	u.reject()
+}
+
+func (u *unmodifiableList[T]) Remove(index int) {
+	u.reject()
+}
+
+func (u *unmodifiableList[T]) TryGet(index int) (T, error) {
+	return u.list.TryGet(index)
+}
+
+// TrySet 不经过 OnMutation 指定的函数，直接返回 collection.ErrUnsupportedOperation。
+func (u *unmodifiableList[T]) TrySet(index int, item T) error {
+	return collection.ErrUnsupportedOperation
+}
+
+// TryRemove 不经过 OnMutation 指定的函数，直接返回 collection.ErrUnsupportedOperation。
+func (u *unmodifiableList[T]) TryRemove(index int) error {
+	return collection.ErrUnsupportedOperation
+}
+
+func (u *unmodifiableList[T]) ReplaceAll(operator func(item T) T) {
+	u.reject()
+}
+
+func (u *unmodifiableList[T]) Equals(other collection.List[T]) bool {
+	return u.list.Equals(other)
+}
+
+func (u *unmodifiableList[T]) HashCode() uint64 {
+	return u.list.HashCode()
+}
+
+func (u *unmodifiableList[T]) IndexOf(item T) int {
+	return u.list.IndexOf(item)
+}
+
+func (u *unmodifiableList[T]) LastIndexOf(item T) int {
+	return u.list.LastIndexOf(item)
+}
+
+func (u *unmodifiableList[T]) Backward() iter.Seq[T] {
+	return u.list.Backward()
+}
+
+type unmodifiableSet[T any] struct {
+	unmodifiableCollection[T]
+	set collection.Set[T]
+}
+
+func (u *unmodifiableSet[T]) Equals(other collection.Set[T]) bool {
+	return u.set.Equals(other)
+}
+
+func (u *unmodifiableSet[T]) HashCode() uint64 {
+	return u.set.HashCode()
+}
+
+func (u *unmodifiableSet[T]) Remove(item T) bool {
+	u.reject()
+	return false
+}
+
+type unmodifiableSortedSet[T any] struct {
+	unmodifiableSet[T]
+	sorted collection.SortedSet[T]
+}
+
+func (u *unmodifiableSortedSet[T]) First() T {
+	return u.sorted.First()
+}
+
+func (u *unmodifiableSortedSet[T]) Last() T {
+	return u.sorted.Last()
+}
+
+func (u *unmodifiableSortedSet[T]) TryFirst() (T, error) {
+	return u.sorted.TryFirst()
+}
+
+func (u *unmodifiableSortedSet[T]) TryLast() (T, error) {
+	return u.sorted.TryLast()
+}
+
+func (u *unmodifiableSortedSet[T]) Backward() iter.Seq[T] {
+	return u.sorted.Backward()
+}
+
+type unmodifiableMap[K comparable, V any] struct {
+	m collection.Map[K, V]
+	guard
+}
+
+func (u *unmodifiableMap[K, V]) Put(key K, value V) {
+	u.reject()
+}
+
+func (u *unmodifiableMap[K, V]) Get(key K) (V, bool) {
+	return u.m.Get(key)
+}
+
+func (u *unmodifiableMap[K, V]) TryGet(key K) (V, error) {
+	return u.m.TryGet(key)
+}
+
+func (u *unmodifiableMap[K, V]) Delete(key K) bool {
+	u.reject()
+	return false
+}
+
+func (u *unmodifiableMap[K, V]) ContainsKey(key K) bool {
+	return u.m.ContainsKey(key)
+}
+
+func (u *unmodifiableMap[K, V]) Size() int {
+	return u.m.Size()
+}
+
+func (u *unmodifiableMap[K, V]) IsEmpty() bool {
+	return u.m.IsEmpty()
+}
+
+func (u *unmodifiableMap[K, V]) Clear() {
+	u.reject()
+}
+
+func (u *unmodifiableMap[K, V]) ForEach(fn func(key K, value V)) {
+	u.m.ForEach(fn)
+}
+
+func (u *unmodifiableMap[K, V]) KeyIterator() collection.Iterator[K] {
+	return &unmodifiableIterator[K]{u.m.KeyIterator(), u.guard}
+}
+
+func (u *unmodifiableMap[K, V]) ValueIterator() collection.Iterator[V] {
+	return &unmodifiableIterator[V]{u.m.ValueIterator(), u.guard}
+}
+
+func (u *unmodifiableMap[K, V]) EntryIterator() collection.Iterator[collection.Entry[K, V]] {
+	return &unmodifiableIterator[collection.Entry[K, V]]{u.m.EntryIterator(), u.guard}
+}
+
+func (u *unmodifiableMap[K, V]) All() iter.Seq2[K, V] {
+	return u.m.All()
+}
+
+func (u *unmodifiableMap[K, V]) Keys() iter.Seq[K] {
+	return u.m.Keys()
+}
+
+func (u *unmodifiableMap[K, V]) Values() iter.Seq[V] {
+	return u.m.Values()
+}
+
+func (u *unmodifiableMap[K, V]) Equals(other collection.Map[K, V]) bool {
+	return u.m.Equals(other)
+}
+
+func (u *unmodifiableMap[K, V]) HashCode() uint64 {
+	return u.m.HashCode()
+}
+
+func (u *unmodifiableMap[K, V]) String() string {
+	return u.m.String()
+}
+
+// unmodifiableIterator 转发遍历操作并拒绝 Remove。
+type unmodifiableIterator[T any] struct {
+	it collection.Iterator[T]
+	guard
+}
+
+func (u *unmodifiableIterator[T]) HasNext() bool {
+	return u.it.HasNext()
+}
+
+func (u *unmodifiableIterator[T]) Next() T {
+	return u.it.Next()
+}
+
+func (u *unmodifiableIterator[T]) Remove() {
+	u.reject()
+}
@@ -0,0 +1,114 @@
+package collections
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/set/treeset"
+	"github.com/herry-hu/go-collections-java/map/hashmap"
+)
+
+func TestUnmodifiableListPanicsByDefault(t *testing.T) {
+	view := UnmodifiableList[int](listOf(1, 2, 3))
+	mutations := map[string]func(){
+		"Add":        func() { view.Add(4) },
+		"AddAll":     func() { view.AddAll(4, 5) },
+		"Clear":      func() { view.Clear() },
+		"Set":        func() { view.Set(0, 9) },
+		"Remove":     func() { view.Remove(0) },
+		"RemoveIf":   func() { view.RemoveIf(func(int) bool { return true }) },
+		"RemoveAll":  func() { view.RemoveAll(listOf(1)) },
+		"RetainAll":  func() { view.RetainAll(listOf(1)) },
+		"ReplaceAll": func() { view.ReplaceAll(func(item int) int { return item }) },
+		"Iterator.Remove": func() {
+			it := view.Iterator()
+			it.Next()
+			it.Remove()
+		},
+		"Sort": func() { SortFunc(view, func(a, b int) int { return b - a }) },
+	}
+	for name, mutate := range mutations {
+		t.Run(name, func(t *testing.T) {
+			expectPanic(t, collection.ErrUnsupportedOperation, mutate)
+		})
+	}
+	if got, want := view.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("view = %v after rejected writes, want %v", got, want)
+	}
+}
+
+func TestOnMutation(t *testing.T) {
+	var rejected []error
+	handler := OnMutation(func(err error) { rejected = append(rejected, err) })
+	list := listOf(1, 2, 3)
+	view := UnmodifiableList[int](list, handler)
+
+	// 处理函数正常返回时写操作被忽略，有返回值的写操作返回false
+	view.Add(4)
+	view.Set(0, 9)
+	view.Remove(0)
+	if view.RemoveIf(func(int) bool { return true }) || view.RetainAll(listOf[int]()) {
+		t.Error("rejected bulk operation returned true")
+	}
+	it := view.Iterator()
+	it.Next()
+	it.Remove()
+	if len(rejected) != 6 {
+		t.Fatalf("handler called %d times, want 6", len(rejected))
+	}
+	for _, err := range rejected {
+		if !errors.Is(err, collection.ErrUnsupportedOperation) {
+			t.Fatalf("handler received %v, want ErrUnsupportedOperation", err)
+		}
+	}
+	if got, want := list.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("underlying list = %v, want %v", got, want)
+	}
+
+	// TrySet 与 TryRemove 直接返回错误，不经过处理函数
+	if err := view.TrySet(0, 9); !errors.Is(err, collection.ErrUnsupportedOperation) {
+		t.Errorf("TrySet error = %v, want ErrUnsupportedOperation", err)
+	}
+	if err := view.TryRemove(0); !errors.Is(err, collection.ErrUnsupportedOperation) {
+		t.Errorf("TryRemove error = %v, want ErrUnsupportedOperation", err)
+	}
+	if len(rejected) != 6 {
+		t.Errorf("TrySet/TryRemove called the handler: %d calls, want 6", len(rejected))
+	}
+
+	// 视图反映底层列表之后的修改
+	list.Add(4)
+	if view.Size() != 4 || view.Get(3) != 4 || !view.Contains(4) {
+		t.Errorf("view does not reflect changes to the list: %v", view)
+	}
+}
+
+func TestUnmodifiableSetAndMap(t *testing.T) {
+	set := treeset.NewOrderedTreeSet[int]()
+	set.AddAll(3, 1, 2)
+	var calls int
+	view := UnmodifiableSortedSet[int](set, OnMutation(func(error) { calls++ }))
+	if view.Remove(1) || view.First() != 1 || view.Last() != 3 {
+		t.Errorf("sorted set view: Remove or First/Last misbehave")
+	}
+	view.Add(0)
+	if calls != 2 || set.Size() != 3 {
+		t.Errorf("handler called %d times and set size is %d, want 2 and 3", calls, set.Size())
+	}
+
+	m := hashmap.NewHashMap[string, int]()
+	m.Put("a", 1)
+	mapView := UnmodifiableMap[string, int](m)
+	expectPanic(t, collection.ErrUnsupportedOperation, func() { mapView.Put("b", 2) })
+	expectPanic(t, collection.ErrUnsupportedOperation, func() { mapView.Delete("a") })
+	expectPanic(t, collection.ErrUnsupportedOperation, func() {
+		it := mapView.EntryIterator()
+		it.Next()
+		it.Remove()
+	})
+	if v, ok := mapView.Get("a"); !ok || v != 1 || mapView.Size() != 1 {
+		t.Errorf("map view Get(a) = %v, %v, size %d", v, ok, mapView.Size())
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/herry-hu/go-collections-java
 
-go 1.20
+go 1.23
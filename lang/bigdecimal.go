@@ -0,0 +1,376 @@
+package lang
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode 指定 BigDecimal 丢弃精度时的舍入方式，对应 Java 的 java.math.RoundingMode。
+type RoundingMode int
+
+const (
+	// RoundUp 远离0舍入：1.1 → 2，-1.1 → -2。
+	RoundUp RoundingMode = iota
+	// RoundDown 向0舍入，即截断：1.9 → 1，-1.9 → -1。
+	RoundDown
+	// RoundCeiling 向正无穷舍入：1.1 → 2，-1.9 → -1。
+	RoundCeiling
+	// RoundFloor 向负无穷舍入：1.9 → 1，-1.1 → -2。
+	RoundFloor
+	// RoundHalfUp 四舍五入，恰好一半时远离0：2.5 → 3，-2.5 → -3。
+	RoundHalfUp
+	// RoundHalfDown 五舍六入，恰好一半时向0：2.5 → 2，-2.5 → -2。
+	RoundHalfDown
+	// RoundHalfEven 银行家舍入，恰好一半时取偶数：2.5 → 2，3.5 → 4。
+	RoundHalfEven
+	// RoundUnnecessary 断言结果是精确的，需要舍入时panic。
+	RoundUnnecessary
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundUp:
+		return "UP"
+	case RoundDown:
+		return "DOWN"
+	case RoundCeiling:
+		return "CEILING"
+	case RoundFloor:
+		return "FLOOR"
+	case RoundHalfUp:
+		return "HALF_UP"
+	case RoundHalfDown:
+		return "HALF_DOWN"
+	case RoundHalfEven:
+		return "HALF_EVEN"
+	case RoundUnnecessary:
+		return "UNNECESSARY"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// BigDecimal 是不可变的任意精度十进制数，值为 unscaled × 10^-scale，对应 Java 的 java.math.BigDecimal。
+//
+// CompareTo 只比较数值，1.0 与 1.00 相等，因此放入 TreeSet 时视为同一个元素；
+// Equals 与 HashCode 同时比较数值与标度，1.0 与 1.00 作为 HashMap 的键时是不同的键，与 Java 一致。零值表示0。
+type BigDecimal struct {
+	unscaled BigInteger // 非标度值
+	scale    int        // 标度，即小数点后的位数，可以为负数
+}
+
+// BigDecimalOf 返回值为 unscaled × 10^-scale 的 BigDecimal，例如 BigDecimalOf(12345, 2) 表示 123.45。
+func BigDecimalOf(unscaled int64, scale int) BigDecimal {
+	return BigDecimal{BigIntegerOf(unscaled), scale}
+}
+
+// NewBigDecimal 返回值为 unscaled × 10^-scale 的 BigDecimal。
+func NewBigDecimal(unscaled BigInteger, scale int) BigDecimal {
+	return BigDecimal{unscaled, scale}
+}
+
+// ParseBigDecimal 解析形如 "-123.45"、"1.5e-3"、".5" 的十进制数，小数点后的位数减去指数即为标度。
+func ParseBigDecimal(s string) (BigDecimal, error) {
+	invalid := fmt.Errorf("%w: invalid BigDecimal %q", ErrNumberFormat, s)
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return BigDecimal{}, invalid
+		}
+		mantissa, exponent = s[:i], e
+	}
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '+' || mantissa[0] == '-') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return BigDecimal{}, invalid
+	}
+	unscaled, ok := new(big.Int).SetString(sign+digits, 10)
+	if !ok {
+		return BigDecimal{}, invalid
+	}
+	return BigDecimal{BigInteger{unscaled}, len(fracPart) - exponent}, nil
+}
+
+// Scale 返回标度。
+func (d BigDecimal) Scale() int {
+	return d.scale
+}
+
+// UnscaledValue 返回非标度值。
+func (d BigDecimal) UnscaledValue() BigInteger {
+	return d.unscaled
+}
+
+// Precision 返回非标度值的十进制位数，0的精度为1。
+func (d BigDecimal) Precision() int {
+	abs := new(big.Int).Abs(d.unscaled.value())
+	if abs.Sign() == 0 {
+		return 1
+	}
+	return len(abs.String())
+}
+
+// Signum 在 d 为负数、0、正数时分别返回-1、0、1。
+func (d BigDecimal) Signum() int {
+	return d.unscaled.Signum()
+}
+
+// SetScale 返回标度为 scale、数值等于 d 按 mode 舍入后的 BigDecimal。
+func (d BigDecimal) SetScale(scale int, mode RoundingMode) BigDecimal {
+	if scale >= d.scale {
+		return BigDecimal{BigInteger{new(big.Int).Mul(d.unscaled.value(), pow10(scale-d.scale))}, scale}
+	}
+	return BigDecimal{BigInteger{divRound(d.unscaled.value(), pow10(d.scale-scale), mode)}, scale}
+}
+
+// Add 返回 d + other，结果的标度为两者标度的较大值。
+func (d BigDecimal) Add(other BigDecimal) BigDecimal {
+	x, y, scale := align(d, other)
+	return BigDecimal{BigInteger{x.Add(x, y)}, scale}
+}
+
+// Subtract 返回 d - other，结果的标度为两者标度的较大值。
+func (d BigDecimal) Subtract(other BigDecimal) BigDecimal {
+	x, y, scale := align(d, other)
+	return BigDecimal{BigInteger{x.Sub(x, y)}, scale}
+}
+
+// Multiply 返回 d * other，结果的标度为两者标度之和。
+func (d BigDecimal) Multiply(other BigDecimal) BigDecimal {
+	return BigDecimal{d.unscaled.Multiply(other.unscaled), d.scale + other.scale}
+}
+
+// Divide 返回标度为 scale、按 mode 舍入的商 d / other，other 为0时panic。
+func (d BigDecimal) Divide(other BigDecimal, scale int, mode RoundingMode) BigDecimal {
+	checkDivisor(other.unscaled.value())
+	// 商的非标度值 = d.unscaled × 10^(scale - d.scale + other.scale) / other.unscaled
+	num := new(big.Int).Set(d.unscaled.value())
+	den := new(big.Int).Set(other.unscaled.value())
+	if e := scale - d.scale + other.scale; e >= 0 {
+		num.Mul(num, pow10(e))
+	} else {
+		den.Mul(den, pow10(-e))
+	}
+	return BigDecimal{BigInteger{divRound(num, den, mode)}, scale}
+}
+
+// DivideExact 返回精确的商 d / other，标度为 d.Scale() - other.Scale() 与能精确表示商的最小标度中的较大值。
+//
+// 商是无限小数（例如 1/3）或 other 为0时panic，此时请使用 Divide 指定标度与舍入方式。
+func (d BigDecimal) DivideExact(other BigDecimal) BigDecimal {
+	checkDivisor(other.unscaled.value())
+	q := new(big.Rat).SetFrac(d.unscaled.value(), other.unscaled.value())
+	// 约分后的分母只含因子2和5时商是有限小数，需要的额外位数是两个因子次数的较大值
+	den := new(big.Int).Set(q.Denom())
+	twos, fives := removeFactor(den, 2), removeFactor(den, 5)
+	if den.Cmp(big.NewInt(1)) != 0 {
+		panic(fmt.Errorf("%w: non-terminating decimal expansion", ErrArithmetic))
+	}
+	extra := max(twos, fives)
+	unscaled := new(big.Int).Mul(q.Num(), pow10(extra))
+	unscaled.Quo(unscaled, q.Denom())
+	return BigDecimal{BigInteger{unscaled}, d.scale - other.scale + extra}
+}
+
+// Negate 返回 -d，标度不变。
+func (d BigDecimal) Negate() BigDecimal {
+	return BigDecimal{d.unscaled.Negate(), d.scale}
+}
+
+// Abs 返回 |d|，标度不变。
+func (d BigDecimal) Abs() BigDecimal {
+	return BigDecimal{d.unscaled.Abs(), d.scale}
+}
+
+// MovePointLeft 返回小数点左移 n 位的结果，即 d × 10^-n。
+func (d BigDecimal) MovePointLeft(n int) BigDecimal {
+	return BigDecimal{d.unscaled, d.scale + n}
+}
+
+// MovePointRight 返回小数点右移 n 位的结果，即 d × 10^n。
+func (d BigDecimal) MovePointRight(n int) BigDecimal {
+	return BigDecimal{d.unscaled, d.scale - n}
+}
+
+// StripTrailingZeros 返回数值相同、去掉非标度值末尾所有0的 BigDecimal，0的标度变为0。
+func (d BigDecimal) StripTrailingZeros() BigDecimal {
+	if d.Signum() == 0 {
+		return BigDecimal{}
+	}
+	unscaled := new(big.Int).Set(d.unscaled.value())
+	scale := d.scale
+	ten, r := big.NewInt(10), new(big.Int)
+	for {
+		q, rem := new(big.Int).QuoRem(unscaled, ten, r)
+		if rem.Sign() != 0 {
+			break
+		}
+		unscaled, scale = q, scale-1
+	}
+	return BigDecimal{BigInteger{unscaled}, scale}
+}
+
+// Min 返回 d 与 other 中数值较小的一个。
+func (d BigDecimal) Min(other BigDecimal) BigDecimal {
+	if d.CompareTo(other) <= 0 {
+		return d
+	}
+	return other
+}
+
+// Max 返回 d 与 other 中数值较大的一个。
+func (d BigDecimal) Max(other BigDecimal) BigDecimal {
+	if d.CompareTo(other) >= 0 {
+		return d
+	}
+	return other
+}
+
+// ToBigInteger 返回向0截断后的整数部分。
+func (d BigDecimal) ToBigInteger() BigInteger {
+	return d.SetScale(0, RoundDown).unscaled
+}
+
+// Float64 返回最接近 d 的 float64。
+func (d BigDecimal) Float64() float64 {
+	f, _ := d.rat().Float64()
+	return f
+}
+
+// IntValue 返回 d 的整数部分转换为 int 的值，超出范围时结果被截断。
+func (d BigDecimal) IntValue() int {
+	return d.ToBigInteger().IntValue()
+}
+
+// LongValue 返回 d 的整数部分转换为 int64 的值，超出范围时结果被截断。
+func (d BigDecimal) LongValue() int64 {
+	return d.ToBigInteger().Int64()
+}
+
+// DoubleValue 与 Float64 相同。
+func (d BigDecimal) DoubleValue() float64 {
+	return d.Float64()
+}
+
+// CompareTo 只比较数值大小，忽略标度：1.0 与 1.00 的比较结果为0。
+func (d BigDecimal) CompareTo(other BigDecimal) int {
+	x, y, _ := align(d, other)
+	return x.Cmp(y)
+}
+
+// Equals 检查数值与标度是否都相等：1.0 与 1.00 不相等。
+func (d BigDecimal) Equals(other BigDecimal) bool {
+	return d.scale == other.scale && d.unscaled.Equals(other.unscaled)
+}
+
+// HashCode 返回与 Equals 一致的哈希码。
+func (d BigDecimal) HashCode() uint64 {
+	return 31*d.unscaled.HashCode() + uint64(d.scale)
+}
+
+// String 返回不使用科学计数法的十进制表示，与 Java 的 toPlainString 一致，例如 "123.450"、"-0.01"、"1200"。
+func (d BigDecimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled.value()).String()
+	sign := ""
+	if d.Signum() < 0 {
+		sign = "-"
+	}
+	switch {
+	case d.scale <= 0:
+		if d.Signum() == 0 {
+			return "0"
+		}
+		return sign + digits + strings.Repeat("0", -d.scale)
+	case len(digits) > d.scale:
+		point := len(digits) - d.scale
+		return sign + digits[:point] + "." + digits[point:]
+	default:
+		return sign + "0." + strings.Repeat("0", d.scale-len(digits)) + digits
+	}
+}
+
+// rat 返回与 d 数值相等的有理数。
+func (d BigDecimal) rat() *big.Rat {
+	if d.scale >= 0 {
+		return new(big.Rat).SetFrac(d.unscaled.value(), pow10(d.scale))
+	}
+	return new(big.Rat).SetInt(new(big.Int).Mul(d.unscaled.value(), pow10(-d.scale)))
+}
+
+// align 将两个数调整到相同的标度，返回两者新的非标度值（新分配的 big.Int）以及共同的标度。
+func align(a, b BigDecimal) (*big.Int, *big.Int, int) {
+	x := new(big.Int).Set(a.unscaled.value())
+	y := new(big.Int).Set(b.unscaled.value())
+	switch {
+	case a.scale < b.scale:
+		x.Mul(x, pow10(b.scale-a.scale))
+		return x, y, b.scale
+	case a.scale > b.scale:
+		y.Mul(y, pow10(a.scale-b.scale))
+	}
+	return x, y, a.scale
+}
+
+// pow10 返回 10^n，n 不能为负数。
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// divRound 返回按 mode 舍入到整数的 num / den。
+func divRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// 精确商的符号；截断得到的 q 需要时再远离0调整一位
+	sign := num.Sign() * den.Sign()
+	// half 为 2|r| 与 |den| 的比较结果，即舍去部分与一半的比较结果
+	twice := new(big.Int).Abs(r)
+	half := twice.Lsh(twice, 1).Cmp(new(big.Int).Abs(den))
+
+	var away bool
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfDown:
+		away = half > 0
+	case RoundHalfEven:
+		away = half > 0 || half == 0 && q.Bit(0) == 1
+	case RoundUnnecessary:
+		panic(fmt.Errorf("%w: rounding necessary", ErrArithmetic))
+	default:
+		panic(fmt.Errorf("%w: unknown rounding mode %v", ErrArithmetic, mode))
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// removeFactor 不断将 n 除以 factor 直到不能整除，返回除去的次数。
+func removeFactor(n *big.Int, factor int64) int {
+	f, q, r := big.NewInt(factor), new(big.Int), new(big.Int)
+	count := 0
+	for {
+		q.QuoRem(n, f, r)
+		if r.Sign() != 0 {
+			return count
+		}
+		n.Set(q)
+		count++
+	}
+}
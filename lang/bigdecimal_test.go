@@ -0,0 +1,139 @@
+package lang
+
+import (
+	"errors"
+	"testing"
+)
+
+// mustParse 解析测试中的常量，解析失败时终止测试。
+func mustParse(t *testing.T, s string) BigDecimal {
+	t.Helper()
+	d, err := ParseBigDecimal(s)
+	if err != nil {
+		t.Fatalf("ParseBigDecimal(%q): %v", s, err)
+	}
+	return d
+}
+
+// expectArithmeticPanic 检查 f 以包装了 ErrArithmetic 的错误panic。
+func expectArithmeticPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		if err, ok := recover().(error); !ok || !errors.Is(err, ErrArithmetic) {
+			t.Fatalf("%s: panic = %v, want ErrArithmetic", name, err)
+		}
+	}()
+	f()
+}
+
+// 与 Java RoundingMode 文档中的对照表一致，"" 表示该舍入方式会抛出 ArithmeticException。
+func TestSetScaleRoundingModes(t *testing.T) {
+	inputs := []string{"5.5", "2.5", "1.6", "1.1", "1.0", "-1.0", "-1.1", "-1.6", "-2.5", "-5.5"}
+	table := map[RoundingMode][]string{
+		RoundUp:          {"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6"},
+		RoundDown:        {"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundCeiling:     {"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundFloor:       {"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6"},
+		RoundHalfUp:      {"6", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"},
+		RoundHalfDown:    {"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"},
+		RoundHalfEven:    {"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6"},
+		RoundUnnecessary: {"", "", "", "", "1", "-1", "", "", "", ""},
+	}
+	for mode, want := range table {
+		for i, input := range inputs {
+			d := mustParse(t, input)
+			if want[i] == "" {
+				expectArithmeticPanic(t, mode.String()+" "+input, func() { d.SetScale(0, mode) })
+				continue
+			}
+			if got := d.SetScale(0, mode); got.String() != want[i] || got.Scale() != 0 {
+				t.Errorf("%s.SetScale(0, %v) = %s (scale %d), want %s", input, mode, got, got.Scale(), want[i])
+			}
+		}
+	}
+}
+
+func TestSetScale(t *testing.T) {
+	tests := []struct {
+		input string
+		scale int
+		mode  RoundingMode
+		want  string
+	}{
+		{"1.5", 3, RoundUnnecessary, "1.500"},
+		{"123.456", 2, RoundHalfUp, "123.46"},
+		{"123.455", 2, RoundHalfEven, "123.46"},
+		{"123.445", 2, RoundHalfEven, "123.44"},
+		{"-0.005", 2, RoundHalfUp, "-0.01"},
+		{"-0.005", 2, RoundHalfDown, "0.00"},
+		{"1250", -2, RoundHalfEven, "1200"},
+		{"1350", -2, RoundHalfEven, "1400"},
+		{"0.999", 1, RoundCeiling, "1.0"},
+	}
+	for _, tt := range tests {
+		got := mustParse(t, tt.input).SetScale(tt.scale, tt.mode)
+		if got.String() != tt.want || got.Scale() != tt.scale {
+			t.Errorf("%s.SetScale(%d, %v) = %s (scale %d), want %s", tt.input, tt.scale, tt.mode, got, got.Scale(), tt.want)
+		}
+	}
+}
+
+func TestDivide(t *testing.T) {
+	tests := []struct {
+		x, y  string
+		scale int
+		mode  RoundingMode
+		want  string
+	}{
+		{"1", "3", 5, RoundHalfUp, "0.33333"},
+		{"2", "3", 2, RoundHalfUp, "0.67"},
+		{"2", "3", 2, RoundDown, "0.66"},
+		{"-2", "3", 2, RoundFloor, "-0.67"},
+		{"-2", "3", 2, RoundCeiling, "-0.66"},
+		{"2", "-3", 2, RoundUp, "-0.67"},
+		{"5", "2", 0, RoundHalfEven, "2"},
+		{"7", "2", 0, RoundHalfEven, "4"},
+		{"-5", "2", 0, RoundHalfDown, "-2"},
+		{"-5", "2", 0, RoundHalfUp, "-3"},
+		{"1.00", "0.3", 3, RoundDown, "3.333"},
+		{"12345", "1", -2, RoundHalfEven, "12300"},
+		{"10", "4", 1, RoundUnnecessary, "2.5"},
+		{"0.001", "1000", 2, RoundUp, "0.01"},
+	}
+	for _, tt := range tests {
+		got := mustParse(t, tt.x).Divide(mustParse(t, tt.y), tt.scale, tt.mode)
+		if got.String() != tt.want || got.Scale() != tt.scale {
+			t.Errorf("%s / %s (scale %d, %v) = %s (scale %d), want %s", tt.x, tt.y, tt.scale, tt.mode, got, got.Scale(), tt.want)
+		}
+	}
+
+	one := BigDecimalOf(1, 0)
+	expectArithmeticPanic(t, "1 / 3 UNNECESSARY", func() { one.Divide(BigDecimalOf(3, 0), 2, RoundUnnecessary) })
+	expectArithmeticPanic(t, "1 / 0", func() { one.Divide(BigDecimal{}, 2, RoundHalfUp) })
+}
+
+func TestDivideExact(t *testing.T) {
+	tests := []struct {
+		x, y  string
+		want  string
+		scale int
+	}{
+		{"1", "8", "0.125", 3},
+		{"1.00", "4", "0.25", 2},
+		{"6.0", "2", "3.0", 1},
+		{"10", "4", "2.5", 1},
+		{"-1", "32", "-0.03125", 5},
+		{"100", "0.5", "200", -1},
+		{"0", "7", "0", 0},
+	}
+	for _, tt := range tests {
+		got := mustParse(t, tt.x).DivideExact(mustParse(t, tt.y))
+		if got.String() != tt.want || got.Scale() != tt.scale {
+			t.Errorf("%s / %s = %s (scale %d), want %s (scale %d)", tt.x, tt.y, got, got.Scale(), tt.want, tt.scale)
+		}
+	}
+
+	expectArithmeticPanic(t, "1 / 3", func() { BigDecimalOf(1, 0).DivideExact(BigDecimalOf(3, 0)) })
+	expectArithmeticPanic(t, "1 / 0", func() { BigDecimalOf(1, 0).DivideExact(BigDecimalOf(0, 2)) })
+}
@@ -0,0 +1,188 @@
+package lang
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+)
+
+// ErrArithmetic 表示非法的算术运算，例如除以0或需要舍入时使用了 Unnecessary，对应 Java 的 ArithmeticException。
+//
+// BigInteger 与 BigDecimal 遇到这类情况时以包装了该错误的error panic，可通过 recover 与 errors.Is 识别。
+var ErrArithmetic = errors.New("arithmetic error")
+
+// BigInteger 是不可变的任意精度整数，对应 Java 的 java.math.BigInteger。
+//
+// 所有运算都返回新值，零值表示0。BigInteger 实现了 Comparable 与 Hashable，可以作为 TreeSet 的元素和 HashMap 的键。
+type BigInteger struct {
+	v *big.Int // 为nil时表示0，创建后不再修改
+}
+
+// BigIntegerOf 返回值为 v 的 BigInteger。
+func BigIntegerOf(v int64) BigInteger {
+	return BigInteger{big.NewInt(v)}
+}
+
+// NewBigInteger 返回值等于 v 的 BigInteger，之后修改 v 不会影响返回值。
+func NewBigInteger(v *big.Int) BigInteger {
+	return BigInteger{new(big.Int).Set(v)}
+}
+
+// ParseBigInteger 解析以 base 进制表示的整数，base 为0时根据 0x、0o、0b 等前缀判断进制。
+func ParseBigInteger(s string, base int) (BigInteger, error) {
+	v, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return BigInteger{}, fmt.Errorf("%w: invalid BigInteger %q", ErrNumberFormat, s)
+	}
+	return BigInteger{v}, nil
+}
+
+// value 返回底层的 big.Int，调用方不能修改它。
+func (b BigInteger) value() *big.Int {
+	if b.v == nil {
+		return new(big.Int)
+	}
+	return b.v
+}
+
+// Big 返回与 b 相等的 big.Int 副本。
+func (b BigInteger) Big() *big.Int {
+	return new(big.Int).Set(b.value())
+}
+
+// Add 返回 b + other。
+func (b BigInteger) Add(other BigInteger) BigInteger {
+	return BigInteger{new(big.Int).Add(b.value(), other.value())}
+}
+
+// Subtract 返回 b - other。
+func (b BigInteger) Subtract(other BigInteger) BigInteger {
+	return BigInteger{new(big.Int).Sub(b.value(), other.value())}
+}
+
+// Multiply 返回 b * other。
+func (b BigInteger) Multiply(other BigInteger) BigInteger {
+	return BigInteger{new(big.Int).Mul(b.value(), other.value())}
+}
+
+// Divide 返回向0截断的商 b / other，other 为0时panic。
+func (b BigInteger) Divide(other BigInteger) BigInteger {
+	checkDivisor(other.value())
+	return BigInteger{new(big.Int).Quo(b.value(), other.value())}
+}
+
+// Remainder 返回与 Divide 对应的余数，符号与 b 相同，other 为0时panic。
+func (b BigInteger) Remainder(other BigInteger) BigInteger {
+	checkDivisor(other.value())
+	return BigInteger{new(big.Int).Rem(b.value(), other.value())}
+}
+
+// Mod 返回 b 模 m 的非负结果，m 必须为正数。
+func (b BigInteger) Mod(m BigInteger) BigInteger {
+	if m.Signum() <= 0 {
+		panic(fmt.Errorf("%w: modulus not positive", ErrArithmetic))
+	}
+	return BigInteger{new(big.Int).Mod(b.value(), m.value())}
+}
+
+// Pow 返回 b 的 exponent 次方，exponent 不能为负数。
+func (b BigInteger) Pow(exponent int) BigInteger {
+	if exponent < 0 {
+		panic(fmt.Errorf("%w: negative exponent", ErrArithmetic))
+	}
+	return BigInteger{new(big.Int).Exp(b.value(), big.NewInt(int64(exponent)), nil)}
+}
+
+// Gcd 返回 |b| 与 |other| 的最大公约数，两者都为0时返回0。
+func (b BigInteger) Gcd(other BigInteger) BigInteger {
+	x := new(big.Int).Abs(b.value())
+	y := new(big.Int).Abs(other.value())
+	return BigInteger{new(big.Int).GCD(nil, nil, x, y)}
+}
+
+// Negate 返回 -b。
+func (b BigInteger) Negate() BigInteger {
+	return BigInteger{new(big.Int).Neg(b.value())}
+}
+
+// Abs 返回 |b|。
+func (b BigInteger) Abs() BigInteger {
+	return BigInteger{new(big.Int).Abs(b.value())}
+}
+
+// Signum 在 b 为负数、0、正数时分别返回-1、0、1。
+func (b BigInteger) Signum() int {
+	return b.value().Sign()
+}
+
+// Min 返回 b 与 other 中较小的一个。
+func (b BigInteger) Min(other BigInteger) BigInteger {
+	if b.CompareTo(other) <= 0 {
+		return b
+	}
+	return other
+}
+
+// Max 返回 b 与 other 中较大的一个。
+func (b BigInteger) Max(other BigInteger) BigInteger {
+	if b.CompareTo(other) >= 0 {
+		return b
+	}
+	return other
+}
+
+// IsInt64 检查 b 能否用 int64 表示。
+func (b BigInteger) IsInt64() bool {
+	return b.value().IsInt64()
+}
+
+// Int64 返回 b 的低64位表示的 int64，超出范围时结果被截断，与 Java 的 longValue 一致。
+func (b BigInteger) Int64() int64 {
+	return b.value().Int64()
+}
+
+// IntValue 返回 b 的低位表示的 int，超出范围时结果被截断。
+func (b BigInteger) IntValue() int {
+	return int(b.value().Int64())
+}
+
+// LongValue 与 Int64 相同。
+func (b BigInteger) LongValue() int64 {
+	return b.Int64()
+}
+
+// DoubleValue 返回最接近 b 的 float64，超出范围时返回无穷。
+func (b BigInteger) DoubleValue() float64 {
+	f, _ := new(big.Float).SetInt(b.value()).Float64()
+	return f
+}
+
+// CompareTo 比较 b 与 other 的大小。
+func (b BigInteger) CompareTo(other BigInteger) int {
+	return b.value().Cmp(other.value())
+}
+
+// Equals 检查 b 与 other 是否相等。
+func (b BigInteger) Equals(other BigInteger) bool {
+	return b.CompareTo(other) == 0
+}
+
+// HashCode 返回 b 的哈希码。
+func (b BigInteger) HashCode() uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write(b.value().Bytes())
+	return hash.Sum64() ^ uint64(b.Signum())
+}
+
+// String 返回 b 的十进制表示。
+func (b BigInteger) String() string {
+	return b.value().String()
+}
+
+// checkDivisor 在除数为0时panic。
+func checkDivisor(divisor *big.Int) {
+	if divisor.Sign() == 0 {
+		panic(fmt.Errorf("%w: division by zero", ErrArithmetic))
+	}
+}
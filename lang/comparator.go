@@ -0,0 +1,116 @@
+package lang
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// Comparator 比较两个元素的顺序，a 小于、等于、大于 b 时分别返回负数、0、正数，对应 Java 的 java.util.Comparator。
+//
+// Comparator 与 func(a, b T) int 可以互相赋值，因此可以直接传给 slices.SortFunc、collections.SortFunc、Stream.Sorted 等。
+type Comparator[T any] func(a, b T) int
+
+// Compare 使用比较器比较 a 与 b。
+func (c Comparator[T]) Compare(a, b T) int {
+	return c(a, b)
+}
+
+// Reversed 返回与 c 顺序相反的比较器。
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenComparing 返回先按 c 比较、相等时再按 other 比较的比较器。
+func (c Comparator[T]) ThenComparing(other Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return other(a, b)
+	}
+}
+
+// NaturalOrder 返回按 cmp.Ordered 自然顺序比较的比较器，lang 中的 Int、String 等数值和字符串包装类型都满足 cmp.Ordered。
+func NaturalOrder[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// ReverseOrder 返回与 NaturalOrder 顺序相反的比较器。
+func ReverseOrder[T cmp.Ordered]() Comparator[T] {
+	return NaturalOrder[T]().Reversed()
+}
+
+// ComparableOrder 返回按 Comparable.CompareTo 比较的比较器，用于 Boolean 以及自行实现了 Comparable 的类型。
+func ComparableOrder[T Comparable[T]]() Comparator[T] {
+	return func(a, b T) int {
+		return a.CompareTo(b)
+	}
+}
+
+// AnyComparableOrder 返回按旧版 CompareTo(interface{}) 比较的比较器，可以配合 NewTreeSetFunc 等继续使用尚未迁移的类型。
+//
+// Deprecated: 请让元素类型实现 Comparable[T] 并使用 ComparableOrder。
+func AnyComparableOrder[T AnyComparable]() Comparator[T] {
+	return func(a, b T) int {
+		return a.CompareTo(b)
+	}
+}
+
+// Comparing 返回按 keyExtractor 提取的键的自然顺序比较的比较器。
+func Comparing[T any, K cmp.Ordered](keyExtractor func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(keyExtractor(a), keyExtractor(b))
+	}
+}
+
+// ComparingFunc 返回按 keyExtractor 提取的键、再用 keyComparator 比较的比较器。
+func ComparingFunc[T any, K any](keyExtractor func(T) K, keyComparator Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return keyComparator(keyExtractor(a), keyExtractor(b))
+	}
+}
+
+// NullsFirst 返回认为nil小于任何非nil值的比较器，两个非nil值用 c 比较；c 为nil时所有非nil值视为相等。
+//
+// nil指的是nil指针、接口、切片、映射、通道和函数，其他类型的值永远不是nil。
+func NullsFirst[T any](c Comparator[T]) Comparator[T] {
+	return nullsComparator(c, -1)
+}
+
+// NullsLast 返回认为nil大于任何非nil值的比较器，其余规则与 NullsFirst 相同。
+func NullsLast[T any](c Comparator[T]) Comparator[T] {
+	return nullsComparator(c, 1)
+}
+
+// nullsComparator 实现 NullsFirst 与 NullsLast，nilOrder 是nil与非nil值比较的结果。
+func nullsComparator[T any](c Comparator[T], nilOrder int) Comparator[T] {
+	return func(a, b T) int {
+		aNil, bNil := isNil(a), isNil(b)
+		switch {
+		case aNil && bNil:
+			return 0
+		case aNil:
+			return nilOrder
+		case bNil:
+			return -nilOrder
+		case c == nil:
+			return 0
+		default:
+			return c(a, b)
+		}
+	}
+}
+
+// isNil 检查 value 是否为nil，包括装在接口中的nil指针。
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package lang
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type label string
+
+func (l label) String() string {
+	return string(l)
+}
+
+type person struct {
+	name string
+	age  int
+}
+
+// sign 将比较结果归一化为 -1、0、1。
+func sign(n int) int {
+	return cmp.Compare(n, 0)
+}
+
+func TestNullsFirstAndLast(t *testing.T) {
+	one, two := 1, 2
+	byValue := Comparator[*int](func(a, b *int) int { return cmp.Compare(*a, *b) })
+	items := []*int{&two, nil, &one, nil}
+
+	first := slices.Clone(items)
+	slices.SortStableFunc(first, NullsFirst(byValue))
+	if first[0] != nil || first[1] != nil || *first[2] != 1 || *first[3] != 2 {
+		t.Errorf("NullsFirst order = %v", first)
+	}
+	last := slices.Clone(items)
+	slices.SortStableFunc(last, NullsLast(byValue))
+	if *last[0] != 1 || *last[1] != 2 || last[2] != nil || last[3] != nil {
+		t.Errorf("NullsLast order = %v", last)
+	}
+
+	// 接口中的nil指针也被视为nil，c 不会收到nil
+	var nilBuilder *strings.Builder
+	byString := Comparator[fmt.Stringer](func(a, b fmt.Stringer) int { return strings.Compare(a.String(), b.String()) })
+	tests := []struct {
+		name                string
+		a, b                fmt.Stringer
+		wantFirst, wantLast int
+	}{
+		{"nil interface", nil, label("a"), -1, 1},
+		{"nil pointer in interface", nilBuilder, label("a"), -1, 1},
+		{"non-nil and nil", label("a"), nilBuilder, 1, -1},
+		{"both nil", nil, nilBuilder, 0, 0},
+		{"non-nil", label("b"), label("a"), 1, 1},
+	}
+	for _, tt := range tests {
+		if got := sign(NullsFirst(byString)(tt.a, tt.b)); got != tt.wantFirst {
+			t.Errorf("NullsFirst %s = %d, want %d", tt.name, got, tt.wantFirst)
+		}
+		if got := sign(NullsLast(byString)(tt.a, tt.b)); got != tt.wantLast {
+			t.Errorf("NullsLast %s = %d, want %d", tt.name, got, tt.wantLast)
+		}
+	}
+
+	// c 为nil时所有非nil值相等
+	if NullsFirst[[]int](nil)([]int{2}, []int{1}) != 0 || NullsFirst[[]int](nil)(nil, []int{1}) >= 0 {
+		t.Error("NullsFirst(nil) does not treat non-nil values as equal")
+	}
+	// 不可能为nil的类型直接使用 c
+	if NullsLast(NaturalOrder[int]())(0, 1) >= 0 {
+		t.Error("NullsLast treated 0 as nil")
+	}
+}
+
+func TestComparatorCombinators(t *testing.T) {
+	people := []person{{"Bob", 30}, {"alice", 25}, {"Carol", 30}, {"dave", 25}, {"Bob", 20}}
+	byAge := Comparing(func(p person) int { return p.age })
+	byName := ComparingFunc(func(p person) string { return p.name }, NaturalOrder[string]())
+
+	sorted := slices.Clone(people)
+	slices.SortStableFunc(sorted, byAge.ThenComparing(byName))
+	want := []person{{"Bob", 20}, {"alice", 25}, {"dave", 25}, {"Bob", 30}, {"Carol", 30}}
+	if !slices.Equal(sorted, want) {
+		t.Errorf("age then name = %v, want %v", sorted, want)
+	}
+
+	// Reversed 只作用于接收者，ThenComparing 的次级比较器保持原来的顺序
+	slices.SortStableFunc(sorted, byAge.Reversed().ThenComparing(byName))
+	want = []person{{"Bob", 30}, {"Carol", 30}, {"alice", 25}, {"dave", 25}, {"Bob", 20}}
+	if !slices.Equal(sorted, want) {
+		t.Errorf("age descending then name = %v, want %v", sorted, want)
+	}
+	// 整个组合比较器反转
+	slices.SortStableFunc(sorted, byAge.ThenComparing(byName).Reversed())
+	want = []person{{"Carol", 30}, {"Bob", 30}, {"dave", 25}, {"alice", 25}, {"Bob", 20}}
+	if !slices.Equal(sorted, want) {
+		t.Errorf("reversed age then name = %v, want %v", sorted, want)
+	}
+
+	if byAge.Reversed().Reversed().Compare(people[0], people[1]) <= 0 {
+		t.Error("Reversed twice is not the original order")
+	}
+	if ReverseOrder[String]()("a", "b") <= 0 || NaturalOrder[Float64]()(1, 2) >= 0 {
+		t.Error("NaturalOrder/ReverseOrder disagree with cmp.Compare")
+	}
+	if ComparableOrder[Boolean]()(false, true) >= 0 || ComparableOrder[Int]()(3, 3) != 0 {
+		t.Error("ComparableOrder does not use CompareTo")
+	}
+}
@@ -0,0 +1,182 @@
+package lang
+
+import (
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// Hashable 是自定义相等性与哈希值的类型，对应 Java 的 Object.equals 与 Object.hashCode。
+//
+// HashMap、ConcurrentHashMap 与 HashSet 的键实现了 Hashable 时，用 Equals 代替 == 判断键是否相同，用 HashCode 代替内置的哈希函数。
+// 实现必须满足：a.Equals(b) 为true时 a.HashCode() == b.HashCode()。Go 的映射键必须是可比较类型，
+// 底层是切片等不可比较类型的键可以用指针实现 Hashable，由 Equals 按内容比较。
+type Hashable[T any] interface {
+	// HashCode 返回值的哈希码。
+	HashCode() uint64
+	// Equals 检查值是否与 other 相等。
+	Equals(other T) bool
+}
+
+// Equal 检查 a 与 b 是否相等：a 实现了 Equals(T) bool（例如 Hashable[T]）时使用 Equals，否则使用 ==。
+//
+// 它与 collection.Equal 相同，容器中的元素与键都按这一规则比较。
+func Equal[T comparable](a, b T) bool {
+	return collection.Equal(a, b)
+}
+
+// HashCodeOf 返回实现了 HashCode() uint64（例如 Hashable[T]）的 key 的哈希码，key 没有实现时返回false。
+func HashCodeOf[T any](key T) (uint64, bool) {
+	if h, ok := any(key).(interface{ HashCode() uint64 }); ok {
+		return h.HashCode(), true
+	}
+	return 0, false
+}
+
+func (b Byte) HashCode() uint64 {
+	return uint64(b)
+}
+
+func (b Byte) Equals(other Byte) bool {
+	return b == other
+}
+
+func (r Rune) HashCode() uint64 {
+	return uint64(r)
+}
+
+func (r Rune) Equals(other Rune) bool {
+	return r == other
+}
+
+func (i Int) HashCode() uint64 {
+	return uint64(i)
+}
+
+func (i Int) Equals(other Int) bool {
+	return i == other
+}
+
+func (i Int8) HashCode() uint64 {
+	return uint64(i)
+}
+
+func (i Int8) Equals(other Int8) bool {
+	return i == other
+}
+
+func (i Int16) HashCode() uint64 {
+	return uint64(i)
+}
+
+func (i Int16) Equals(other Int16) bool {
+	return i == other
+}
+
+func (i Int32) HashCode() uint64 {
+	return uint64(i)
+}
+
+func (i Int32) Equals(other Int32) bool {
+	return i == other
+}
+
+func (i Int64) HashCode() uint64 {
+	return uint64(i)
+}
+
+func (i Int64) Equals(other Int64) bool {
+	return i == other
+}
+
+func (u Uint) HashCode() uint64 {
+	return uint64(u)
+}
+
+func (u Uint) Equals(other Uint) bool {
+	return u == other
+}
+
+func (u Uint8) HashCode() uint64 {
+	return uint64(u)
+}
+
+func (u Uint8) Equals(other Uint8) bool {
+	return u == other
+}
+
+func (u Uint16) HashCode() uint64 {
+	return uint64(u)
+}
+
+func (u Uint16) Equals(other Uint16) bool {
+	return u == other
+}
+
+func (u Uint32) HashCode() uint64 {
+	return uint64(u)
+}
+
+func (u Uint32) Equals(other Uint32) bool {
+	return u == other
+}
+
+func (u Uint64) HashCode() uint64 {
+	return uint64(u)
+}
+
+func (u Uint64) Equals(other Uint64) bool {
+	return u == other
+}
+
+// HashCode 与 == 保持一致：0 与 -0 的哈希码相同。
+func (f Float32) HashCode() uint64 {
+	return collection.FloatHash(float64(f))
+}
+
+func (f Float32) Equals(other Float32) bool {
+	return f == other
+}
+
+// HashCode 与 == 保持一致：0 与 -0 的哈希码相同。
+func (f Float64) HashCode() uint64 {
+	return collection.FloatHash(float64(f))
+}
+
+func (f Float64) Equals(other Float64) bool {
+	return f == other
+}
+
+func (c Complex64) HashCode() uint64 {
+	return collection.FloatHash(float64(real(c)))*31 + collection.FloatHash(float64(imag(c)))
+}
+
+func (c Complex64) Equals(other Complex64) bool {
+	return c == other
+}
+
+func (c Complex128) HashCode() uint64 {
+	return collection.FloatHash(real(c))*31 + collection.FloatHash(imag(c))
+}
+
+func (c Complex128) Equals(other Complex128) bool {
+	return c == other
+}
+
+// HashCode 与 Java 的 String.hashCode 兼容：int32(s.HashCode()) 等于 Java 对同一字符串计算的结果。
+func (s String) HashCode() uint64 {
+	return uint64(uint32(s.javaHashCode()))
+}
+
+func (s String) Equals(other String) bool {
+	return s == other
+}
+
+func (b Boolean) HashCode() uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (b Boolean) Equals(other Boolean) bool {
+	return b == other
+}
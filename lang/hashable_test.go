@@ -0,0 +1,90 @@
+package lang
+
+import (
+	"math"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+var (
+	_ Hashable[Int]        = Int(0)
+	_ Hashable[Uint64]     = Uint64(0)
+	_ Hashable[Float64]    = Float64(0)
+	_ Hashable[Complex128] = Complex128(0)
+	_ Hashable[String]     = String("")
+	_ Hashable[Boolean]    = Boolean(false)
+	_ Hashable[BigInteger] = BigInteger{}
+	_ Hashable[BigDecimal] = BigDecimal{}
+)
+
+// checkHashable 检查 a.Equals(b) 的结果为 equal，且相等的值哈希码相同。
+func checkHashable[T interface {
+	comparable
+	Hashable[T]
+}](t *testing.T, name string, a, b T, equal bool) {
+	t.Helper()
+	if a.Equals(b) != equal || b.Equals(a) != equal {
+		t.Errorf("%s: Equals = %v, want %v", name, a.Equals(b), equal)
+	}
+	if equal && a.HashCode() != b.HashCode() {
+		t.Errorf("%s: equal values have hash codes %d and %d", name, a.HashCode(), b.HashCode())
+	}
+	// 容器使用的 collection.Equal 与 collection.HashCode 必须委托给 Equals 与 HashCode
+	if collection.Equal(a, b) != equal || collection.HashCode(a) != a.HashCode() {
+		t.Errorf("%s: collection.Equal/HashCode do not delegate to the Hashable methods", name)
+	}
+}
+
+func TestHashableContract(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+	checkHashable(t, "Int", Int(42), Int(42), true)
+	checkHashable(t, "Int8", Int8(-1), Int8(1), false)
+	checkHashable(t, "Uint64", Uint64(math.MaxUint64), Uint64(math.MaxUint64), true)
+	checkHashable(t, "Float64 zeros", Float64(0), Float64(negativeZero), true)
+	checkHashable(t, "Float32 zeros", Float32(0), Float32(negativeZero), true)
+	checkHashable(t, "Float64 NaN", Float64(math.NaN()), Float64(math.NaN()), false)
+	checkHashable(t, "Complex128 zeros", Complex128(complex(0, negativeZero)), Complex128(complex(negativeZero, 0)), true)
+	checkHashable(t, "String", String("hello"), String("hel"+"lo"), true)
+	checkHashable(t, "String case", String("hello"), String("Hello"), false)
+	checkHashable(t, "Boolean", Boolean(true), Boolean(true), true)
+	checkHashable(t, "BigInteger", BigIntegerOf(1<<40), BigIntegerOf(1<<40), true)
+	checkHashable(t, "BigDecimal", BigDecimalOf(150, 2), BigDecimalOf(150, 2), true)
+	checkHashable(t, "BigDecimal scale", BigDecimalOf(150, 2), BigDecimalOf(15, 1), false)
+}
+
+func TestStringHashCodeMatchesJava(t *testing.T) {
+	// 期望值来自 Java 的 "...".hashCode()
+	tests := map[String]int32{
+		"":            0,
+		"a":           97,
+		"Aa":          2112,
+		"BB":          2112,
+		"hello":       99162322,
+		"Hello World": -862545276,
+		"中文":          646394,
+		// BMP 之外的字符按 UTF-16 代理对的两个代码单元计算
+		"😀":      1772899,
+		"a😀":     1866116,
+		"𠀀":      1772480,
+		"😀😀":     1705528838,
+		"Go语言🚀!": 1595215363,
+	}
+	for s, want := range tests {
+		if got := int32(s.HashCode()); got != want {
+			t.Errorf("String(%q).HashCode() = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestHashCodeOf(t *testing.T) {
+	if h, ok := HashCodeOf(Int(7)); !ok || h != 7 {
+		t.Errorf("HashCodeOf(Int(7)) = %d, %v, want 7, true", h, ok)
+	}
+	if _, ok := HashCodeOf(7); ok {
+		t.Error("HashCodeOf(7) reported a HashCode method on a plain int")
+	}
+	if !Equal(String("a"), String("a")) || Equal(BigDecimalOf(1, 0), BigDecimalOf(10, 1)) {
+		t.Error("Equal does not delegate to collection.Equal")
+	}
+}
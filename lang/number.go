@@ -0,0 +1,743 @@
+package lang
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrNumberFormat 表示无法解析的数字字符串，对应 Java 的 NumberFormatException。
+//
+// ParseInt、ParseFloat64、ValueOf 等函数返回的错误同时包装了它与 strconv 的 *NumError，
+// 可以用 errors.Is(err, ErrNumberFormat) 识别，用 errors.Is(err, strconv.ErrRange) 区分超出范围与格式错误。
+var ErrNumberFormat = errors.New("number format error")
+
+// Number 是可以转换为基本数值类型的值，对应 Java 的 java.lang.Number。
+//
+// 整数之间的转换与 Go 的类型转换相同，超出范围时截断。浮点数转换为整数时与 Java 一致：向0截断，NaN 转换为0，
+// 超出范围时取最接近的边界值。
+type Number interface {
+	// IntValue 返回转换为 int 的值。
+	IntValue() int
+	// LongValue 返回转换为 int64 的值。
+	LongValue() int64
+	// DoubleValue 返回转换为 float64 的值，可能损失精度。
+	DoubleValue() float64
+}
+
+// 各类型的取值范围，对应 Java 的 MIN_VALUE 与 MAX_VALUE。
+// 与 Java 相同，浮点数的 MinValue 是最小的正数而不是最小的负数。
+const (
+	ByteMinValue    Byte    = 0
+	ByteMaxValue    Byte    = math.MaxUint8
+	IntMinValue     Int     = math.MinInt
+	IntMaxValue     Int     = math.MaxInt
+	Int8MinValue    Int8    = math.MinInt8
+	Int8MaxValue    Int8    = math.MaxInt8
+	Int16MinValue   Int16   = math.MinInt16
+	Int16MaxValue   Int16   = math.MaxInt16
+	Int32MinValue   Int32   = math.MinInt32
+	Int32MaxValue   Int32   = math.MaxInt32
+	Int64MinValue   Int64   = math.MinInt64
+	Int64MaxValue   Int64   = math.MaxInt64
+	UintMinValue    Uint    = 0
+	UintMaxValue    Uint    = math.MaxUint
+	Uint8MinValue   Uint8   = 0
+	Uint8MaxValue   Uint8   = math.MaxUint8
+	Uint16MinValue  Uint16  = 0
+	Uint16MaxValue  Uint16  = math.MaxUint16
+	Uint32MinValue  Uint32  = 0
+	Uint32MaxValue  Uint32  = math.MaxUint32
+	Uint64MinValue  Uint64  = 0
+	Uint64MaxValue  Uint64  = math.MaxUint64
+	RuneMinValue    Rune    = 0
+	RuneMaxValue    Rune    = unicode.MaxRune
+	Float32MinValue Float32 = math.SmallestNonzeroFloat32
+	Float32MaxValue Float32 = math.MaxFloat32
+	Float64MinValue Float64 = math.SmallestNonzeroFloat64
+	Float64MaxValue Float64 = math.MaxFloat64
+)
+
+// ValueOf 以十进制解析 s，对应 Java 的 Integer.valueOf(s)、Double.valueOf(s) 等方法，T 可以是任意整数类型、浮点数类型或 Boolean。
+func ValueOf[T Byte | Int | Int8 | Int16 | Int32 | Int64 | Uint | Uint8 | Uint16 | Uint32 | Uint64 | Float32 | Float64 | Boolean](s string) (T, error) {
+	var v any
+	var err error
+	switch any(*new(T)).(type) {
+	case Byte:
+		v, err = ParseByte(s, 10)
+	case Int:
+		v, err = ParseInt(s, 10)
+	case Int8:
+		v, err = ParseInt8(s, 10)
+	case Int16:
+		v, err = ParseInt16(s, 10)
+	case Int32:
+		v, err = ParseInt32(s, 10)
+	case Int64:
+		v, err = ParseInt64(s, 10)
+	case Uint:
+		v, err = ParseUint(s, 10)
+	case Uint8:
+		v, err = ParseUint8(s, 10)
+	case Uint16:
+		v, err = ParseUint16(s, 10)
+	case Uint32:
+		v, err = ParseUint32(s, 10)
+	case Uint64:
+		v, err = ParseUint64(s, 10)
+	case Float32:
+		v, err = ParseFloat32(s)
+	case Float64:
+		v, err = ParseFloat64(s)
+	case Boolean:
+		v, err = ParseBoolean(s)
+	}
+	return v.(T), err
+}
+
+// ParseByte 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseByte(s string, radix int) (Byte, error) {
+	v, err := strconv.ParseUint(s, radix, 8)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Byte(v), nil
+}
+
+// ParseInt 以 radix 进制解析 s，对应 Java 的 Integer.parseInt(s, radix)。s 可以带有正负号，
+// radix 为0时根据 0x、0o、0b 等前缀判断进制；格式错误或超出范围时返回包装了 ErrNumberFormat 的错误。
+func ParseInt(s string, radix int) (Int, error) {
+	v, err := strconv.ParseInt(s, radix, strconv.IntSize)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Int(v), nil
+}
+
+// ParseInt8 以 radix 进制解析 s，规则与 ParseInt 相同。
+func ParseInt8(s string, radix int) (Int8, error) {
+	v, err := strconv.ParseInt(s, radix, 8)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Int8(v), nil
+}
+
+// ParseInt16 以 radix 进制解析 s，规则与 ParseInt 相同。
+func ParseInt16(s string, radix int) (Int16, error) {
+	v, err := strconv.ParseInt(s, radix, 16)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Int16(v), nil
+}
+
+// ParseInt32 以 radix 进制解析 s，规则与 ParseInt 相同。
+func ParseInt32(s string, radix int) (Int32, error) {
+	v, err := strconv.ParseInt(s, radix, 32)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Int32(v), nil
+}
+
+// ParseInt64 以 radix 进制解析 s，规则与 ParseInt 相同。
+func ParseInt64(s string, radix int) (Int64, error) {
+	v, err := strconv.ParseInt(s, radix, 64)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Int64(v), nil
+}
+
+// ParseUint 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseUint(s string, radix int) (Uint, error) {
+	v, err := strconv.ParseUint(s, radix, strconv.IntSize)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Uint(v), nil
+}
+
+// ParseUint8 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseUint8(s string, radix int) (Uint8, error) {
+	v, err := strconv.ParseUint(s, radix, 8)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Uint8(v), nil
+}
+
+// ParseUint16 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseUint16(s string, radix int) (Uint16, error) {
+	v, err := strconv.ParseUint(s, radix, 16)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Uint16(v), nil
+}
+
+// ParseUint32 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseUint32(s string, radix int) (Uint32, error) {
+	v, err := strconv.ParseUint(s, radix, 32)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Uint32(v), nil
+}
+
+// ParseUint64 以 radix 进制解析无符号整数 s，规则与 ParseInt 相同，但 s 不能带有正负号。
+func ParseUint64(s string, radix int) (Uint64, error) {
+	v, err := strconv.ParseUint(s, radix, 64)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Uint64(v), nil
+}
+
+// ParseFloat32 解析十进制或十六进制的浮点数，对应 Java 的 Float.parseFloat，
+// 可以解析 "NaN"、"Infinity" 与 "-Infinity"。
+func ParseFloat32(s string) (Float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Float32(v), nil
+}
+
+// ParseFloat64 解析十进制或十六进制的浮点数，对应 Java 的 Double.parseDouble，
+// 可以解析 "NaN"、"Infinity" 与 "-Infinity"。
+func ParseFloat64(s string) (Float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, numberFormatError(err)
+	}
+	return Float64(v), nil
+}
+
+// ParseBoolean 忽略大小写将 "true" 与 "false" 解析为 Boolean。Java 的 Boolean.parseBoolean 把其他字符串都当作false，
+// 这里则与其他解析函数一样返回包装了 ErrNumberFormat 与 strconv.ErrSyntax 的错误，以便发现配置中的拼写错误。
+func ParseBoolean(s string) (Boolean, error) {
+	switch {
+	case strings.EqualFold(s, "true"):
+		return true, nil
+	case strings.EqualFold(s, "false"):
+		return false, nil
+	}
+	return false, numberFormatError(&strconv.NumError{Func: "ParseBoolean", Num: s, Err: strconv.ErrSyntax})
+}
+
+// Float32NaN 返回 Float32 的 NaN，对应 Java 的 Float.NaN。
+func Float32NaN() Float32 {
+	return Float32(math.NaN())
+}
+
+// Float32Infinity 在 sign 大于等于0时返回正无穷，否则返回负无穷，对应 Java 的 Float.POSITIVE_INFINITY 与 NEGATIVE_INFINITY。
+func Float32Infinity(sign int) Float32 {
+	return Float32(math.Inf(sign))
+}
+
+// Float64NaN 返回 Float64 的 NaN，对应 Java 的 Double.NaN。
+func Float64NaN() Float64 {
+	return Float64(math.NaN())
+}
+
+// Float64Infinity 在 sign 大于等于0时返回正无穷，否则返回负无穷，对应 Java 的 Double.POSITIVE_INFINITY 与 NEGATIVE_INFINITY。
+func Float64Infinity(sign int) Float64 {
+	return Float64(math.Inf(sign))
+}
+
+// ToString 返回 b 的 radix 进制表示，规则与 Int.ToString 相同。
+func (b Byte) ToString(radix int) string {
+	return strconv.FormatUint(uint64(b), checkRadix(radix))
+}
+
+func (b Byte) IntValue() int {
+	return int(b)
+}
+
+func (b Byte) LongValue() int64 {
+	return int64(b)
+}
+
+func (b Byte) DoubleValue() float64 {
+	return float64(b)
+}
+
+// BitCount 返回 b 的二进制补码表示中1的个数。
+func (b Byte) BitCount() int {
+	return bits.OnesCount8(uint8(b))
+}
+
+// NumberOfLeadingZeros 返回 b 的二进制补码表示中最高位的1之前0的个数，b 为0时返回类型的位数。
+func (b Byte) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros8(uint8(b))
+}
+
+// NumberOfTrailingZeros 返回 b 的二进制补码表示中最低位的1之后0的个数，b 为0时返回类型的位数。
+func (b Byte) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros8(uint8(b))
+}
+
+// Reverse 返回将 b 的二进制位顺序颠倒后的值。
+func (b Byte) Reverse() Byte {
+	return Byte(bits.Reverse8(uint8(b)))
+}
+
+// ToString 返回 i 的 radix 进制表示，负数带有负号，radix 不在2到36之间时使用十进制，与 Java 的 Integer.toString(i, radix) 一致。
+func (i Int) ToString(radix int) string {
+	return strconv.FormatInt(int64(i), checkRadix(radix))
+}
+
+func (i Int) IntValue() int {
+	return int(i)
+}
+
+func (i Int) LongValue() int64 {
+	return int64(i)
+}
+
+func (i Int) DoubleValue() float64 {
+	return float64(i)
+}
+
+// BitCount 返回 i 的二进制补码表示中1的个数。
+func (i Int) BitCount() int {
+	return bits.OnesCount(uint(i))
+}
+
+// NumberOfLeadingZeros 返回 i 的二进制补码表示中最高位的1之前0的个数，i 为0时返回类型的位数。
+func (i Int) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros(uint(i))
+}
+
+// NumberOfTrailingZeros 返回 i 的二进制补码表示中最低位的1之后0的个数，i 为0时返回类型的位数。
+func (i Int) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros(uint(i))
+}
+
+// Reverse 返回将 i 的二进制位顺序颠倒后的值。
+func (i Int) Reverse() Int {
+	return Int(bits.Reverse(uint(i)))
+}
+
+// ToString 返回 i 的 radix 进制表示，规则与 Int.ToString 相同。
+func (i Int8) ToString(radix int) string {
+	return strconv.FormatInt(int64(i), checkRadix(radix))
+}
+
+func (i Int8) IntValue() int {
+	return int(i)
+}
+
+func (i Int8) LongValue() int64 {
+	return int64(i)
+}
+
+func (i Int8) DoubleValue() float64 {
+	return float64(i)
+}
+
+// BitCount 返回 i 的二进制补码表示中1的个数。
+func (i Int8) BitCount() int {
+	return bits.OnesCount8(uint8(i))
+}
+
+// NumberOfLeadingZeros 返回 i 的二进制补码表示中最高位的1之前0的个数，i 为0时返回类型的位数。
+func (i Int8) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros8(uint8(i))
+}
+
+// NumberOfTrailingZeros 返回 i 的二进制补码表示中最低位的1之后0的个数，i 为0时返回类型的位数。
+func (i Int8) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros8(uint8(i))
+}
+
+// Reverse 返回将 i 的二进制位顺序颠倒后的值。
+func (i Int8) Reverse() Int8 {
+	return Int8(bits.Reverse8(uint8(i)))
+}
+
+// ToString 返回 i 的 radix 进制表示，规则与 Int.ToString 相同。
+func (i Int16) ToString(radix int) string {
+	return strconv.FormatInt(int64(i), checkRadix(radix))
+}
+
+func (i Int16) IntValue() int {
+	return int(i)
+}
+
+func (i Int16) LongValue() int64 {
+	return int64(i)
+}
+
+func (i Int16) DoubleValue() float64 {
+	return float64(i)
+}
+
+// BitCount 返回 i 的二进制补码表示中1的个数。
+func (i Int16) BitCount() int {
+	return bits.OnesCount16(uint16(i))
+}
+
+// NumberOfLeadingZeros 返回 i 的二进制补码表示中最高位的1之前0的个数，i 为0时返回类型的位数。
+func (i Int16) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros16(uint16(i))
+}
+
+// NumberOfTrailingZeros 返回 i 的二进制补码表示中最低位的1之后0的个数，i 为0时返回类型的位数。
+func (i Int16) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros16(uint16(i))
+}
+
+// Reverse 返回将 i 的二进制位顺序颠倒后的值。
+func (i Int16) Reverse() Int16 {
+	return Int16(bits.Reverse16(uint16(i)))
+}
+
+// ToString 返回 i 的 radix 进制表示，规则与 Int.ToString 相同。
+func (i Int32) ToString(radix int) string {
+	return strconv.FormatInt(int64(i), checkRadix(radix))
+}
+
+func (i Int32) IntValue() int {
+	return int(i)
+}
+
+func (i Int32) LongValue() int64 {
+	return int64(i)
+}
+
+func (i Int32) DoubleValue() float64 {
+	return float64(i)
+}
+
+// BitCount 返回 i 的二进制补码表示中1的个数。
+func (i Int32) BitCount() int {
+	return bits.OnesCount32(uint32(i))
+}
+
+// NumberOfLeadingZeros 返回 i 的二进制补码表示中最高位的1之前0的个数，i 为0时返回类型的位数。
+func (i Int32) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros32(uint32(i))
+}
+
+// NumberOfTrailingZeros 返回 i 的二进制补码表示中最低位的1之后0的个数，i 为0时返回类型的位数。
+func (i Int32) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros32(uint32(i))
+}
+
+// Reverse 返回将 i 的二进制位顺序颠倒后的值。
+func (i Int32) Reverse() Int32 {
+	return Int32(bits.Reverse32(uint32(i)))
+}
+
+// ToString 返回 i 的 radix 进制表示，规则与 Int.ToString 相同。
+func (i Int64) ToString(radix int) string {
+	return strconv.FormatInt(int64(i), checkRadix(radix))
+}
+
+func (i Int64) IntValue() int {
+	return int(i)
+}
+
+func (i Int64) LongValue() int64 {
+	return int64(i)
+}
+
+func (i Int64) DoubleValue() float64 {
+	return float64(i)
+}
+
+// BitCount 返回 i 的二进制补码表示中1的个数。
+func (i Int64) BitCount() int {
+	return bits.OnesCount64(uint64(i))
+}
+
+// NumberOfLeadingZeros 返回 i 的二进制补码表示中最高位的1之前0的个数，i 为0时返回类型的位数。
+func (i Int64) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros64(uint64(i))
+}
+
+// NumberOfTrailingZeros 返回 i 的二进制补码表示中最低位的1之后0的个数，i 为0时返回类型的位数。
+func (i Int64) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros64(uint64(i))
+}
+
+// Reverse 返回将 i 的二进制位顺序颠倒后的值。
+func (i Int64) Reverse() Int64 {
+	return Int64(bits.Reverse64(uint64(i)))
+}
+
+// ToString 返回 u 的 radix 进制表示，规则与 Int.ToString 相同。
+func (u Uint) ToString(radix int) string {
+	return strconv.FormatUint(uint64(u), checkRadix(radix))
+}
+
+func (u Uint) IntValue() int {
+	return int(u)
+}
+
+func (u Uint) LongValue() int64 {
+	return int64(u)
+}
+
+func (u Uint) DoubleValue() float64 {
+	return float64(u)
+}
+
+// BitCount 返回 u 的二进制补码表示中1的个数。
+func (u Uint) BitCount() int {
+	return bits.OnesCount(uint(u))
+}
+
+// NumberOfLeadingZeros 返回 u 的二进制补码表示中最高位的1之前0的个数，u 为0时返回类型的位数。
+func (u Uint) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros(uint(u))
+}
+
+// NumberOfTrailingZeros 返回 u 的二进制补码表示中最低位的1之后0的个数，u 为0时返回类型的位数。
+func (u Uint) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros(uint(u))
+}
+
+// Reverse 返回将 u 的二进制位顺序颠倒后的值。
+func (u Uint) Reverse() Uint {
+	return Uint(bits.Reverse(uint(u)))
+}
+
+// ToString 返回 u 的 radix 进制表示，规则与 Int.ToString 相同。
+func (u Uint8) ToString(radix int) string {
+	return strconv.FormatUint(uint64(u), checkRadix(radix))
+}
+
+func (u Uint8) IntValue() int {
+	return int(u)
+}
+
+func (u Uint8) LongValue() int64 {
+	return int64(u)
+}
+
+func (u Uint8) DoubleValue() float64 {
+	return float64(u)
+}
+
+// BitCount 返回 u 的二进制补码表示中1的个数。
+func (u Uint8) BitCount() int {
+	return bits.OnesCount8(uint8(u))
+}
+
+// NumberOfLeadingZeros 返回 u 的二进制补码表示中最高位的1之前0的个数，u 为0时返回类型的位数。
+func (u Uint8) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros8(uint8(u))
+}
+
+// NumberOfTrailingZeros 返回 u 的二进制补码表示中最低位的1之后0的个数，u 为0时返回类型的位数。
+func (u Uint8) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros8(uint8(u))
+}
+
+// Reverse 返回将 u 的二进制位顺序颠倒后的值。
+func (u Uint8) Reverse() Uint8 {
+	return Uint8(bits.Reverse8(uint8(u)))
+}
+
+// ToString 返回 u 的 radix 进制表示，规则与 Int.ToString 相同。
+func (u Uint16) ToString(radix int) string {
+	return strconv.FormatUint(uint64(u), checkRadix(radix))
+}
+
+func (u Uint16) IntValue() int {
+	return int(u)
+}
+
+func (u Uint16) LongValue() int64 {
+	return int64(u)
+}
+
+func (u Uint16) DoubleValue() float64 {
+	return float64(u)
+}
+
+// BitCount 返回 u 的二进制补码表示中1的个数。
+func (u Uint16) BitCount() int {
+	return bits.OnesCount16(uint16(u))
+}
+
+// NumberOfLeadingZeros 返回 u 的二进制补码表示中最高位的1之前0的个数，u 为0时返回类型的位数。
+func (u Uint16) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros16(uint16(u))
+}
+
+// NumberOfTrailingZeros 返回 u 的二进制补码表示中最低位的1之后0的个数，u 为0时返回类型的位数。
+func (u Uint16) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros16(uint16(u))
+}
+
+// Reverse 返回将 u 的二进制位顺序颠倒后的值。
+func (u Uint16) Reverse() Uint16 {
+	return Uint16(bits.Reverse16(uint16(u)))
+}
+
+// ToString 返回 u 的 radix 进制表示，规则与 Int.ToString 相同。
+func (u Uint32) ToString(radix int) string {
+	return strconv.FormatUint(uint64(u), checkRadix(radix))
+}
+
+func (u Uint32) IntValue() int {
+	return int(u)
+}
+
+func (u Uint32) LongValue() int64 {
+	return int64(u)
+}
+
+func (u Uint32) DoubleValue() float64 {
+	return float64(u)
+}
+
+// BitCount 返回 u 的二进制补码表示中1的个数。
+func (u Uint32) BitCount() int {
+	return bits.OnesCount32(uint32(u))
+}
+
+// NumberOfLeadingZeros 返回 u 的二进制补码表示中最高位的1之前0的个数，u 为0时返回类型的位数。
+func (u Uint32) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros32(uint32(u))
+}
+
+// NumberOfTrailingZeros 返回 u 的二进制补码表示中最低位的1之后0的个数，u 为0时返回类型的位数。
+func (u Uint32) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros32(uint32(u))
+}
+
+// Reverse 返回将 u 的二进制位顺序颠倒后的值。
+func (u Uint32) Reverse() Uint32 {
+	return Uint32(bits.Reverse32(uint32(u)))
+}
+
+// ToString 返回 u 的 radix 进制表示，规则与 Int.ToString 相同。
+func (u Uint64) ToString(radix int) string {
+	return strconv.FormatUint(uint64(u), checkRadix(radix))
+}
+
+func (u Uint64) IntValue() int {
+	return int(u)
+}
+
+func (u Uint64) LongValue() int64 {
+	return int64(u)
+}
+
+func (u Uint64) DoubleValue() float64 {
+	return float64(u)
+}
+
+// BitCount 返回 u 的二进制补码表示中1的个数。
+func (u Uint64) BitCount() int {
+	return bits.OnesCount64(uint64(u))
+}
+
+// NumberOfLeadingZeros 返回 u 的二进制补码表示中最高位的1之前0的个数，u 为0时返回类型的位数。
+func (u Uint64) NumberOfLeadingZeros() int {
+	return bits.LeadingZeros64(uint64(u))
+}
+
+// NumberOfTrailingZeros 返回 u 的二进制补码表示中最低位的1之后0的个数，u 为0时返回类型的位数。
+func (u Uint64) NumberOfTrailingZeros() int {
+	return bits.TrailingZeros64(uint64(u))
+}
+
+// Reverse 返回将 u 的二进制位顺序颠倒后的值。
+func (u Uint64) Reverse() Uint64 {
+	return Uint64(bits.Reverse64(uint64(u)))
+}
+
+// IntValue 向0截断，NaN 返回0，超出 int 的范围时返回最接近的边界值。
+func (f Float32) IntValue() int {
+	return int(floatToInt64(float64(f), math.MinInt, math.MaxInt))
+}
+
+// LongValue 向0截断，NaN 返回0，超出 int64 的范围时返回最接近的边界值。
+func (f Float32) LongValue() int64 {
+	return floatToInt64(float64(f), math.MinInt64, math.MaxInt64)
+}
+
+func (f Float32) DoubleValue() float64 {
+	return float64(f)
+}
+
+// IsNaN 检查 f 是否为 NaN。
+func (f Float32) IsNaN() bool {
+	return math.IsNaN(float64(f))
+}
+
+// IsInfinite 检查 f 是否为正无穷或负无穷。
+func (f Float32) IsInfinite() bool {
+	return math.IsInf(float64(f), 0)
+}
+
+// IsFinite 检查 f 既不是 NaN 也不是无穷。
+func (f Float32) IsFinite() bool {
+	return !f.IsNaN() && !f.IsInfinite()
+}
+
+// IntValue 向0截断，NaN 返回0，超出 int 的范围时返回最接近的边界值。
+func (f Float64) IntValue() int {
+	return int(floatToInt64(float64(f), math.MinInt, math.MaxInt))
+}
+
+// LongValue 向0截断，NaN 返回0，超出 int64 的范围时返回最接近的边界值。
+func (f Float64) LongValue() int64 {
+	return floatToInt64(float64(f), math.MinInt64, math.MaxInt64)
+}
+
+func (f Float64) DoubleValue() float64 {
+	return float64(f)
+}
+
+// IsNaN 检查 f 是否为 NaN。
+func (f Float64) IsNaN() bool {
+	return math.IsNaN(float64(f))
+}
+
+// IsInfinite 检查 f 是否为正无穷或负无穷。
+func (f Float64) IsInfinite() bool {
+	return math.IsInf(float64(f), 0)
+}
+
+// IsFinite 检查 f 既不是 NaN 也不是无穷。
+func (f Float64) IsFinite() bool {
+	return !f.IsNaN() && !f.IsInfinite()
+}
+
+// floatToInt64 按 Java 的规则将 f 转换为 [lo, hi] 范围内的整数。
+func floatToInt64(f float64, lo, hi int64) int64 {
+	switch {
+	case math.IsNaN(f):
+		return 0
+	case f >= float64(hi):
+		return hi
+	case f <= float64(lo):
+		return lo
+	}
+	return int64(f)
+}
+
+// checkRadix 在 radix 不在2到36之间时返回10。
+func checkRadix(radix int) int {
+	if radix < 2 || radix > 36 {
+		return 10
+	}
+	return radix
+}
+
+// numberFormatError 将 strconv 的解析错误包装为 ErrNumberFormat。
+func numberFormatError(err error) error {
+	return fmt.Errorf("%w: %w", ErrNumberFormat, err)
+}
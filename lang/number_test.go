@@ -0,0 +1,276 @@
+package lang
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+// checkParseError 检查 err 同时包装了 ErrNumberFormat 与 target。
+func checkParseError(t *testing.T, name string, err, target error) {
+	t.Helper()
+	if !errors.Is(err, ErrNumberFormat) || !errors.Is(err, target) {
+		t.Errorf("%s: error = %v, want ErrNumberFormat and %v", name, err, target)
+	}
+}
+
+func TestParseIntRadix(t *testing.T) {
+	tests := []struct {
+		s     string
+		radix int
+		want  Int64
+	}{
+		{"0x1F", 0, 31},
+		{"-0x10", 0, -16},
+		{"0b101", 0, 5},
+		{"0o17", 0, 15},
+		{"017", 0, 15},
+		{"1_000", 0, 1000},
+		{"+42", 10, 42},
+		{"-101", 2, -5},
+		{"7fffffff", 16, math.MaxInt32},
+		{"-FF", 16, -255},
+		{"zz", 36, 1295},
+		{"-ZZ", 36, -1295},
+	}
+	for _, tt := range tests {
+		if got, err := ParseInt64(tt.s, tt.radix); err != nil || got != tt.want {
+			t.Errorf("ParseInt64(%q, %d) = %d, %v, want %d", tt.s, tt.radix, got, err, tt.want)
+		}
+		if got, err := ParseInt(tt.s, tt.radix); err != nil || Int64(got) != tt.want {
+			t.Errorf("ParseInt(%q, %d) = %d, %v, want %d", tt.s, tt.radix, got, err, tt.want)
+		}
+	}
+
+	syntax := []struct {
+		s     string
+		radix int
+	}{
+		{"", 10}, {"12a", 10}, {"2", 2}, {"0x1F", 16}, {"1_000", 10}, {"--1", 10}, {" 1", 10}, {"g", 16},
+	}
+	for _, tt := range syntax {
+		_, err := ParseInt64(tt.s, tt.radix)
+		checkParseError(t, strconv.Quote(tt.s), err, strconv.ErrSyntax)
+	}
+	// 与 Java 一致，radix 超出范围时也返回 ErrNumberFormat
+	for _, radix := range []int{-1, 1, 37} {
+		if _, err := ParseInt("1", radix); !errors.Is(err, ErrNumberFormat) {
+			t.Errorf("ParseInt(1, %d) error = %v, want ErrNumberFormat", radix, err)
+		}
+	}
+}
+
+// plusOne 返回十进制整数 s 加 delta 后的十进制表示。
+func plusOne(s string, delta int64) string {
+	n, _ := new(big.Int).SetString(s, 10)
+	return n.Add(n, big.NewInt(delta)).String()
+}
+
+func TestParseIntRange(t *testing.T) {
+	signed := []struct {
+		name     string
+		min, max int64
+		parse    func(s string) error
+	}{
+		{"ParseInt", math.MinInt, math.MaxInt, func(s string) error { _, err := ParseInt(s, 10); return err }},
+		{"ParseInt8", math.MinInt8, math.MaxInt8, func(s string) error { _, err := ParseInt8(s, 10); return err }},
+		{"ParseInt16", math.MinInt16, math.MaxInt16, func(s string) error { _, err := ParseInt16(s, 10); return err }},
+		{"ParseInt32", math.MinInt32, math.MaxInt32, func(s string) error { _, err := ParseInt32(s, 10); return err }},
+		{"ParseInt64", math.MinInt64, math.MaxInt64, func(s string) error { _, err := ParseInt64(s, 10); return err }},
+	}
+	for _, tt := range signed {
+		lo, hi := strconv.FormatInt(tt.min, 10), strconv.FormatInt(tt.max, 10)
+		if tt.parse(lo) != nil || tt.parse(hi) != nil {
+			t.Errorf("%s rejects its MIN_VALUE or MAX_VALUE", tt.name)
+		}
+		checkParseError(t, tt.name+" MIN-1", tt.parse(plusOne(lo, -1)), strconv.ErrRange)
+		checkParseError(t, tt.name+" MAX+1", tt.parse(plusOne(hi, 1)), strconv.ErrRange)
+	}
+
+	unsigned := []struct {
+		name  string
+		max   uint64
+		parse func(s string) error
+	}{
+		{"ParseByte", math.MaxUint8, func(s string) error { _, err := ParseByte(s, 10); return err }},
+		{"ParseUint", math.MaxUint, func(s string) error { _, err := ParseUint(s, 10); return err }},
+		{"ParseUint8", math.MaxUint8, func(s string) error { _, err := ParseUint8(s, 10); return err }},
+		{"ParseUint16", math.MaxUint16, func(s string) error { _, err := ParseUint16(s, 10); return err }},
+		{"ParseUint32", math.MaxUint32, func(s string) error { _, err := ParseUint32(s, 10); return err }},
+		{"ParseUint64", math.MaxUint64, func(s string) error { _, err := ParseUint64(s, 10); return err }},
+	}
+	for _, tt := range unsigned {
+		hi := strconv.FormatUint(tt.max, 10)
+		if tt.parse("0") != nil || tt.parse(hi) != nil {
+			t.Errorf("%s rejects 0 or its MAX_VALUE", tt.name)
+		}
+		checkParseError(t, tt.name+" MAX+1", tt.parse(plusOne(hi, 1)), strconv.ErrRange)
+		// 无符号解析函数不接受任何正负号
+		for _, s := range []string{"+1", "-1", "-0"} {
+			checkParseError(t, tt.name+" "+s, tt.parse(s), strconv.ErrSyntax)
+		}
+	}
+}
+
+func TestParseUintRadix(t *testing.T) {
+	if v, err := ParseUint64("ffffffffffffffff", 16); err != nil || v != math.MaxUint64 {
+		t.Errorf("ParseUint64(ffffffffffffffff, 16) = %d, %v", v, err)
+	}
+	if v, err := ParseUint16("0b1111", 0); err != nil || v != 15 {
+		t.Errorf("ParseUint16(0b1111, 0) = %d, %v", v, err)
+	}
+	if v, err := ParseByte("11111111", 2); err != nil || v != 255 {
+		t.Errorf("ParseByte(11111111, 2) = %d, %v", v, err)
+	}
+	if v, err := ParseUint32("1z141z3", 36); err != nil || v != math.MaxUint32 {
+		t.Errorf("ParseUint32(1z141z3, 36) = %d, %v", v, err)
+	}
+}
+
+// checkValueOf 检查 ValueOf[T](s) 返回 want，want 为 nil 时检查返回了 ErrNumberFormat 与零值。
+func checkValueOf[T Byte | Int | Int8 | Int16 | Int32 | Int64 | Uint | Uint8 | Uint16 | Uint32 | Uint64 | Float32 | Float64 | Boolean](t *testing.T, s string, want T, ok bool) {
+	t.Helper()
+	got, err := ValueOf[T](s)
+	if !ok {
+		var zero T
+		if !errors.Is(err, ErrNumberFormat) || got != zero {
+			t.Errorf("ValueOf[%T](%q) = %v, %v, want zero value and ErrNumberFormat", want, s, got, err)
+		}
+		return
+	}
+	if err != nil || got != want {
+		t.Errorf("ValueOf[%T](%q) = %v, %v, want %v", want, s, got, err, want)
+	}
+}
+
+func TestValueOf(t *testing.T) {
+	checkValueOf[Byte](t, "200", 200, true)
+	checkValueOf[Int](t, "-42", -42, true)
+	checkValueOf[Int8](t, "-128", -128, true)
+	checkValueOf[Int16](t, "32767", 32767, true)
+	checkValueOf[Int32](t, "-2147483648", math.MinInt32, true)
+	checkValueOf[Int64](t, "9223372036854775807", math.MaxInt64, true)
+	checkValueOf[Uint](t, "42", 42, true)
+	checkValueOf[Uint8](t, "255", 255, true)
+	checkValueOf[Uint16](t, "65535", 65535, true)
+	checkValueOf[Uint32](t, "4294967295", math.MaxUint32, true)
+	checkValueOf[Uint64](t, "18446744073709551615", math.MaxUint64, true)
+	checkValueOf[Float32](t, "1.5", 1.5, true)
+	checkValueOf[Float64](t, "-Infinity", Float64(math.Inf(-1)), true)
+	checkValueOf[Boolean](t, "TRUE", true, true)
+
+	// ValueOf 总是使用十进制，不识别进制前缀
+	checkValueOf[Byte](t, "256", 0, false)
+	checkValueOf[Int](t, "0x10", 0, false)
+	checkValueOf[Int8](t, "128", 0, false)
+	checkValueOf[Int16](t, "1e3", 0, false)
+	checkValueOf[Int32](t, "", 0, false)
+	checkValueOf[Int64](t, "12 ", 0, false)
+	checkValueOf[Uint](t, "-1", 0, false)
+	checkValueOf[Uint8](t, "+1", 0, false)
+	checkValueOf[Uint16](t, "65536", 0, false)
+	checkValueOf[Uint32](t, "1.0", 0, false)
+	checkValueOf[Uint64](t, "18446744073709551616", 0, false)
+	checkValueOf[Float32](t, "1,5", 0, false)
+	checkValueOf[Float64](t, "one", 0, false)
+	checkValueOf[Boolean](t, "yes", false, false)
+}
+
+func TestFloatToInteger(t *testing.T) {
+	below := math.Nextafter(1<<63, 0) // 小于 2^63 的最大 float64
+	tests := []struct {
+		f    float64
+		want int64
+	}{
+		{math.NaN(), 0},
+		{math.Inf(1), math.MaxInt64},
+		{math.Inf(-1), math.MinInt64},
+		{1 << 63, math.MaxInt64},
+		{below, int64(below)},
+		{-(1 << 63), math.MinInt64},
+		{-1e19, math.MinInt64},
+		{1e19, math.MaxInt64},
+		{1.9, 1},
+		{-1.9, -1},
+		{math.Copysign(0, -1), 0},
+	}
+	for _, tt := range tests {
+		if got := Float64(tt.f).LongValue(); got != tt.want {
+			t.Errorf("Float64(%g).LongValue() = %d, want %d", tt.f, got, tt.want)
+		}
+		// 只检查能被 float32 精确表示的值
+		if got := Float32(tt.f).LongValue(); float64(float32(tt.f)) == tt.f && got != tt.want {
+			t.Errorf("Float32(%g).LongValue() = %d, want %d", tt.f, got, tt.want)
+		}
+	}
+	if got := Float32NaN().IntValue(); got != 0 {
+		t.Errorf("Float32(NaN).IntValue() = %d, want 0", got)
+	}
+	if got := Float32(math.MaxFloat32).IntValue(); got != math.MaxInt {
+		t.Errorf("Float32(MaxFloat32).IntValue() = %d, want MaxInt", got)
+	}
+	if got := Float64(-math.MaxFloat64).IntValue(); got != math.MinInt {
+		t.Errorf("Float64(-MaxFloat64).IntValue() = %d, want MinInt", got)
+	}
+}
+
+// checkBits 检查 v 的各个位运算方法的结果。
+func checkBits[T interface {
+	comparable
+	BitCount() int
+	NumberOfLeadingZeros() int
+	NumberOfTrailingZeros() int
+	Reverse() T
+}](t *testing.T, v T, bitCount, leading, trailing int, reverse T) {
+	t.Helper()
+	if got := v.BitCount(); got != bitCount {
+		t.Errorf("%T(%v).BitCount() = %d, want %d", v, v, got, bitCount)
+	}
+	if got := v.NumberOfLeadingZeros(); got != leading {
+		t.Errorf("%T(%v).NumberOfLeadingZeros() = %d, want %d", v, v, got, leading)
+	}
+	if got := v.NumberOfTrailingZeros(); got != trailing {
+		t.Errorf("%T(%v).NumberOfTrailingZeros() = %d, want %d", v, v, got, trailing)
+	}
+	if got := v.Reverse(); got != reverse {
+		t.Errorf("%T(%v).Reverse() = %v, want %v", v, v, got, reverse)
+	}
+}
+
+// 负数按二进制补码计算，与 Java 的 Integer.bitCount、Integer.reverse 等方法一致。
+func TestBitOperations(t *testing.T) {
+	checkBits(t, Int8(-1), 8, 0, 0, Int8(-1))
+	checkBits(t, Int8(-128), 1, 0, 7, Int8(1))
+	checkBits(t, Int16(-256), 8, 0, 8, Int16(255))
+	checkBits(t, Int32(-2), 31, 0, 1, Int32(math.MaxInt32))
+	checkBits(t, Int32(1), 1, 31, 0, Int32(math.MinInt32))
+	checkBits(t, Int32(0), 0, 32, 32, Int32(0))
+	checkBits(t, Int64(-8), 61, 0, 3, Int64(1<<61-1))
+	checkBits(t, Int64(math.MinInt64), 1, 0, 63, Int64(1))
+	checkBits(t, Int(-1), strconv.IntSize, 0, 0, Int(-1))
+	checkBits(t, Uint16(1), 1, 15, 0, Uint16(1<<15))
+	checkBits(t, Uint64(math.MaxUint64), 64, 0, 0, Uint64(math.MaxUint64))
+	checkBits(t, Byte(6), 2, 5, 1, Byte(0x60))
+}
+
+func TestToString(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{Int8(-128).ToString(16), "-80"},
+		{Int(-255).ToString(2), "-11111111"},
+		{Int32(math.MinInt32).ToString(36), "-zik0zk"},
+		{Int64(math.MaxInt64).ToString(36), "1y2p0ij32e8e7"},
+		{Uint64(math.MaxUint64).ToString(16), "ffffffffffffffff"},
+		{Byte(255).ToString(2), "11111111"},
+		{Int(255).ToString(1), "255"},
+		{Uint8(255).ToString(37), "255"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("ToString = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package lang
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// Optional 是可能包含也可能不包含值的容器，对应 Java 的 java.util.Optional。
+//
+// 与返回零值相比，Optional 能区分"没有值"与"值恰好是零值"；零值的 Optional 等同于 Empty。
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Of 返回包含 value 的 Optional。
+func Of[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+// Empty 返回不包含值的 Optional。
+func Empty[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// OfOk 将 Go 惯用的 (value, ok) 返回值转换为 Optional，ok 为false时返回 Empty。
+func OfOk[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return Empty[T]()
+	}
+	return Of(value)
+}
+
+// IsPresent 检查是否包含值。
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// IsEmpty 检查是否不包含值。
+func (o Optional[T]) IsEmpty() bool {
+	return !o.present
+}
+
+// Get 返回包含的值，不包含值时以 collection.ErrNoSuchElement panic。
+func (o Optional[T]) Get() T {
+	if !o.present {
+		panic(collection.ErrNoSuchElement)
+	}
+	return o.value
+}
+
+// Value 以 Go 惯用的 (value, ok) 形式返回包含的值。
+func (o Optional[T]) Value() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse 返回包含的值，不包含值时返回 other。
+func (o Optional[T]) OrElse(other T) T {
+	if o.present {
+		return o.value
+	}
+	return other
+}
+
+// OrElseGet 返回包含的值，不包含值时返回 supplier 的结果，supplier 只在需要时调用。
+func (o Optional[T]) OrElseGet(supplier func() T) T {
+	if o.present {
+		return o.value
+	}
+	return supplier()
+}
+
+// Filter 包含的值满足 predicate 时返回自身，否则返回 Empty。
+func (o Optional[T]) Filter(predicate func(T) bool) Optional[T] {
+	if o.present && predicate(o.value) {
+		return o
+	}
+	return Empty[T]()
+}
+
+// IfPresent 包含值时对其执行 action。
+func (o Optional[T]) IfPresent(action func(T)) {
+	if o.present {
+		action(o.value)
+	}
+}
+
+// IfPresentOrElse 包含值时对其执行 action，否则执行 emptyAction。
+func (o Optional[T]) IfPresentOrElse(action func(T), emptyAction func()) {
+	if o.present {
+		action(o.value)
+	} else {
+		emptyAction()
+	}
+}
+
+// String 返回 Optional 的字符串表示形式。
+func (o Optional[T]) String() string {
+	if o.present {
+		return fmt.Sprintf("Optional[%v]", o.value)
+	}
+	return "Optional.empty"
+}
+
+// MapOptional 包含值时返回对其应用 mapper 的结果，否则返回 Empty。
+//
+// Go 的方法不能有类型参数，因此 Java 的 Optional.map 以包级函数提供。
+func MapOptional[T any, R any](o Optional[T], mapper func(T) R) Optional[R] {
+	if !o.present {
+		return Empty[R]()
+	}
+	return Of(mapper(o.value))
+}
+
+// FlatMapOptional 包含值时返回 mapper 的结果，否则返回 Empty。
+func FlatMapOptional[T any, R any](o Optional[T], mapper func(T) Optional[R]) Optional[R] {
+	if !o.present {
+		return Empty[R]()
+	}
+	return mapper(o.value)
+}
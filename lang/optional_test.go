@@ -0,0 +1,88 @@
+package lang
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+func TestOptionalPresence(t *testing.T) {
+	var zero Optional[int]
+	var nilPointer *int
+	tests := []struct {
+		name    string
+		o       Optional[*int]
+		present bool
+	}{
+		{"zero value", Optional[*int]{}, false},
+		{"Empty", Empty[*int](), false},
+		{"Of(nil)", Of(nilPointer), true}, // 与 Java 不同，nil 也是一个值
+		{"OfOk(false)", OfOk(new(int), false), false},
+		{"OfOk(true)", OfOk(nilPointer, true), true},
+	}
+	for _, tt := range tests {
+		if tt.o.IsPresent() != tt.present || tt.o.IsEmpty() == tt.present {
+			t.Errorf("%s: IsPresent = %v, want %v", tt.name, tt.o.IsPresent(), tt.present)
+		}
+	}
+	if zero.String() != "Optional.empty" || Of(0).String() != "Optional[0]" {
+		t.Errorf("String() = %q, %q", zero.String(), Of(0).String())
+	}
+
+	// 包含零值与不包含值是不同的
+	if v, ok := Of(0).Value(); !ok || v != 0 {
+		t.Errorf("Of(0).Value() = %d, %v", v, ok)
+	}
+	if _, ok := zero.Value(); ok {
+		t.Error("zero Optional reports a value")
+	}
+	defer func() {
+		if err, ok := recover().(error); !ok || !errors.Is(err, collection.ErrNoSuchElement) {
+			t.Errorf("Get on Empty: panic = %v, want ErrNoSuchElement", err)
+		}
+	}()
+	zero.Get()
+	t.Error("Get on Empty did not panic")
+}
+
+func TestOptionalOperations(t *testing.T) {
+	calls := 0
+	supplier := func() int { calls++; return -1 }
+	if Of(3).OrElseGet(supplier) != 3 || calls != 0 {
+		t.Error("OrElseGet called the supplier for a present value")
+	}
+	if Empty[int]().OrElseGet(supplier) != -1 || calls != 1 {
+		t.Error("OrElseGet did not call the supplier for an empty value")
+	}
+	if Of(3).OrElse(9) != 3 || Empty[int]().OrElse(9) != 9 {
+		t.Error("OrElse returned the wrong value")
+	}
+
+	even := func(n int) bool { return n%2 == 0 }
+	if Of(3).Filter(even).IsPresent() || !Of(4).Filter(even).IsPresent() || Empty[int]().Filter(even).IsPresent() {
+		t.Error("Filter kept or dropped the wrong values")
+	}
+
+	var got []string
+	Of(1).IfPresent(func(int) { got = append(got, "present") })
+	Empty[int]().IfPresent(func(int) { got = append(got, "unexpected") })
+	Of(1).IfPresentOrElse(func(int) { got = append(got, "action") }, func() { got = append(got, "unexpected") })
+	Empty[int]().IfPresentOrElse(func(int) { got = append(got, "unexpected") }, func() { got = append(got, "empty") })
+	if len(got) != 3 || got[0] != "present" || got[1] != "action" || got[2] != "empty" {
+		t.Errorf("IfPresent/IfPresentOrElse ran %v", got)
+	}
+
+	length := func(s string) int { return len(s) }
+	if o := MapOptional(Of("abc"), length); o.Get() != 3 {
+		t.Errorf("MapOptional = %v, want Optional[3]", o)
+	}
+	if MapOptional(Empty[string](), length).IsPresent() {
+		t.Error("MapOptional of Empty is present")
+	}
+	positive := func(n int) Optional[int] { return OfOk(n, n > 0) }
+	if !FlatMapOptional(Of(1), positive).IsPresent() || FlatMapOptional(Of(-1), positive).IsPresent() ||
+		FlatMapOptional(Empty[int](), positive).IsPresent() {
+		t.Error("FlatMapOptional did not return the mapper's result")
+	}
+}
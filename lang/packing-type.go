@@ -2,270 +2,225 @@ package lang
 
 import "strings"
 
-type Comparable interface {
-	CompareTo(other interface{}) int
+// Comparable 是可以与 T 类型的值比较顺序的类型，对应 Java 的 java.lang.Comparable<T>。
+//
+// CompareTo 在接收者小于、等于、大于 other 时分别返回负数、0、正数。作为类型约束时通常写作 T Comparable[T]，
+// 这样比较不同类型（例如 Int 与 Int64）会在编译期报错，而不是在运行时panic。
+type Comparable[T any] interface {
+	CompareTo(other T) int
 }
 
+// AnyComparable 是旧版以 interface{} 为参数的 Comparable，仍然以这种方式实现 CompareTo 的类型可以通过 AnyComparableOrder 使用比较器。
+//
+// Deprecated: 请实现 Comparable[T]，即 CompareTo(other T) int。
+type AnyComparable = Comparable[any]
+
 type Byte byte
 
-func (b Byte) CompareTo(other interface{}) int {
-	if c, ok := other.(Byte); ok {
-		if b < c {
-			return -1
-		} else if b > c {
-			return 1
-		} else {
-			return 0
-		}
+func (b Byte) CompareTo(c Byte) int {
+	if b < c {
+		return -1
+	} else if b > c {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Byte")
 }
 
 type Rune rune
 
-func (r Rune) CompareTo(other interface{}) int {
-	if s, ok := other.(Rune); ok {
-		if r < s {
-			return -1
-		} else if r > s {
-			return 1
-		} else {
-			return 0
-		}
+func (r Rune) CompareTo(s Rune) int {
+	if r < s {
+		return -1
+	} else if r > s {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Rune")
 }
 
 type Int int
 
-func (i Int) CompareTo(other interface{}) int {
-	if j, ok := other.(Int); ok {
-		if i < j {
-			return -1
-		} else if i > j {
-			return 1
-		} else {
-			return 0
-		}
+func (i Int) CompareTo(j Int) int {
+	if i < j {
+		return -1
+	} else if i > j {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not an Int")
 }
 
 type Int8 int8
 
-func (i Int8) CompareTo(other interface{}) int {
-	if j, ok := other.(Int8); ok {
-		if i < j {
-			return -1
-		} else if i > j {
-			return 1
-		} else {
-			return 0
-		}
+func (i Int8) CompareTo(j Int8) int {
+	if i < j {
+		return -1
+	} else if i > j {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not an Int8")
 }
 
 type Int16 int16
 
-func (i Int16) CompareTo(other interface{}) int {
-	if j, ok := other.(Int16); ok {
-		if i < j {
-			return -1
-		} else if i > j {
-			return 1
-		} else {
-			return 0
-		}
+func (i Int16) CompareTo(j Int16) int {
+	if i < j {
+		return -1
+	} else if i > j {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not an Int16")
 }
 
 type Int32 int32
 
-func (i Int32) CompareTo(other interface{}) int {
-	if j, ok := other.(Int32); ok {
-		if i < j {
-			return -1
-		} else if i > j {
-			return 1
-		} else {
-			return 0
-		}
+func (i Int32) CompareTo(j Int32) int {
+	if i < j {
+		return -1
+	} else if i > j {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not an Int32")
 }
 
 type Int64 int64
 
-func (i Int64) CompareTo(other interface{}) int {
-	if j, ok := other.(Int64); ok {
-		if i < j {
-			return -1
-		} else if i > j {
-			return 1
-		} else {
-			return 0
-		}
+func (i Int64) CompareTo(j Int64) int {
+	if i < j {
+		return -1
+	} else if i > j {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not an Int64")
 }
 
 type Uint uint
 
-func (u Uint) CompareTo(other interface{}) int {
-	if v, ok := other.(Uint); ok {
-		if u < v {
-			return -1
-		} else if u > v {
-			return 1
-		} else {
-			return 0
-		}
+func (u Uint) CompareTo(v Uint) int {
+	if u < v {
+		return -1
+	} else if u > v {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Uint")
 }
 
 type Uint8 uint8
 
-func (u Uint8) CompareTo(other interface{}) int {
-	if v, ok := other.(Uint8); ok {
-		if u < v {
-			return -1
-		} else if u > v {
-			return 1
-		} else {
-			return 0
-		}
+func (u Uint8) CompareTo(v Uint8) int {
+	if u < v {
+		return -1
+	} else if u > v {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Uint8")
 }
 
 type Uint16 uint16
 
-func (u Uint16) CompareTo(other interface{}) int {
-	if v, ok := other.(Uint16); ok {
-		if u < v {
-			return -1
-		} else if u > v {
-			return 1
-		} else {
-			return 0
-		}
+func (u Uint16) CompareTo(v Uint16) int {
+	if u < v {
+		return -1
+	} else if u > v {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Uint16")
 }
 
 type Uint32 uint32
 
-func (u Uint32) CompareTo(other interface{}) int {
-	if v, ok := other.(Uint32); ok {
-		if u < v {
-			return -1
-		} else if u > v {
-			return 1
-		} else {
-			return 0
-		}
+func (u Uint32) CompareTo(v Uint32) int {
+	if u < v {
+		return -1
+	} else if u > v {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Uint32")
 }
 
 type Uint64 uint64
 
-func (u Uint64) CompareTo(other interface{}) int {
-	if v, ok := other.(Uint64); ok {
-		if u < v {
-			return -1
-		} else if u > v {
-			return 1
-		} else {
-			return 0
-		}
+func (u Uint64) CompareTo(v Uint64) int {
+	if u < v {
+		return -1
+	} else if u > v {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Uint64")
 }
 
 type Float32 float32
 
-func (f Float32) CompareTo(other interface{}) int {
-	if g, ok := other.(Float32); ok {
-		if f < g {
-			return -1
-		} else if f > g {
-			return 1
-		} else {
-			return 0
-		}
+func (f Float32) CompareTo(g Float32) int {
+	if f < g {
+		return -1
+	} else if f > g {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Float32")
 }
 
 type Float64 float64
 
-func (f Float64) CompareTo(other interface{}) int {
-	if g, ok := other.(Float64); ok {
-		if f < g {
-			return -1
-		} else if f > g {
-			return 1
-		} else {
-			return 0
-		}
+func (f Float64) CompareTo(g Float64) int {
+	if f < g {
+		return -1
+	} else if f > g {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Float64")
 }
 
 type Complex64 complex64
 
-func (c Complex64) CompareTo(other interface{}) int {
-	if d, ok := other.(Complex64); ok {
-		if real(c) < real(d) || (real(c) == real(d) && imag(c) < imag(d)) {
-			return -1
-		} else if real(c) > real(d) || (real(c) == real(d) && imag(c) > imag(d)) {
-			return 1
-		} else {
-			return 0
-		}
+func (c Complex64) CompareTo(d Complex64) int {
+	if real(c) < real(d) || (real(c) == real(d) && imag(c) < imag(d)) {
+		return -1
+	} else if real(c) > real(d) || (real(c) == real(d) && imag(c) > imag(d)) {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Complex64")
 }
 
 type Complex128 complex128
 
-func (c Complex128) CompareTo(other interface{}) int {
-	if d, ok := other.(Complex128); ok {
-		if real(c) < real(d) || (real(c) == real(d) && imag(c) < imag(d)) {
-			return -1
-		} else if real(c) > real(d) || (real(c) == real(d) && imag(c) > imag(d)) {
-			return 1
-		} else {
-			return 0
-		}
+func (c Complex128) CompareTo(d Complex128) int {
+	if real(c) < real(d) || (real(c) == real(d) && imag(c) < imag(d)) {
+		return -1
+	} else if real(c) > real(d) || (real(c) == real(d) && imag(c) > imag(d)) {
+		return 1
+	} else {
+		return 0
 	}
-	panic("not a Complex128")
 }
 
 type String string
 
-func (s String) CompareTo(other interface{}) int {
-	if t, ok := other.(String); ok {
-		return strings.Compare(string(s), string(t))
-	}
-	panic("not a String")
+func (s String) CompareTo(t String) int {
+	return strings.Compare(string(s), string(t))
 }
 
 type Boolean bool
 
-func (b Boolean) CompareTo(other interface{}) int {
-	if c, ok := other.(Boolean); ok {
-		if b == c {
-			return 0
-		} else if b {
-			return 1
-		} else {
-			return -1
-		}
+func (b Boolean) CompareTo(c Boolean) int {
+	if b == c {
+		return 0
+	} else if b {
+		return 1
+	} else {
+		return -1
 	}
-	panic("not a Boolean")
 }
@@ -0,0 +1,239 @@
+package lang
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/list/arraylist"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// String 的方法对应 Java 的 java.lang.String。与 Java 按 UTF-16 代码单元计数不同，所有索引和长度都按 rune 计数，
+// 因此中文、emoji 等字符都只占一个位置；索引越界时以 *collection.IndexOutOfBoundsError panic。
+// 接受正则表达式的方法使用 Go 的 regexp 语法，表达式无效时panic。
+
+// Format 按 format 格式化 args，对应 Java 的 String.format；格式动词使用 fmt 的语法，%s、%d、%x 等与 Java 相同。
+func Format(format String, args ...any) String {
+	return String(fmt.Sprintf(string(format), args...))
+}
+
+// Length 返回字符串中 rune 的数量。
+func (s String) Length() int {
+	return utf8.RuneCountInString(string(s))
+}
+
+// IsEmpty 检查字符串是否为空。
+func (s String) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// IsBlank 检查字符串是否为空或只包含空白字符。
+func (s String) IsBlank() bool {
+	return strings.TrimSpace(string(s)) == ""
+}
+
+// CharAt 返回第 index 个 rune。
+func (s String) CharAt(index int) rune {
+	runes := []rune(s)
+	if err := collection.CheckIndex(index, len(runes)); err != nil {
+		panic(err)
+	}
+	return runes[index]
+}
+
+// Substring 返回从第 begin 个 rune 开始到第 end 个 rune 之前的子串。
+func (s String) Substring(begin, end int) String {
+	runes := []rune(s)
+	if err := collection.CheckPositionIndex(end, len(runes)); err != nil {
+		panic(err)
+	}
+	if err := collection.CheckPositionIndex(begin, end); err != nil {
+		panic(err)
+	}
+	return String(runes[begin:end])
+}
+
+// SubstringFrom 返回从第 begin 个 rune 开始到末尾的子串。
+func (s String) SubstringFrom(begin int) String {
+	return s.Substring(begin, s.Length())
+}
+
+// IndexOf 返回 str 第一次出现的 rune 位置，不存在时返回-1。
+func (s String) IndexOf(str String) int {
+	return s.runeIndex(strings.Index(string(s), string(str)))
+}
+
+// LastIndexOf 返回 str 最后一次出现的 rune 位置，不存在时返回-1。
+func (s String) LastIndexOf(str String) int {
+	return s.runeIndex(strings.LastIndex(string(s), string(str)))
+}
+
+// runeIndex 将字节偏移转换为 rune 位置，-1 保持不变。
+func (s String) runeIndex(byteIndex int) int {
+	if byteIndex < 0 {
+		return -1
+	}
+	return utf8.RuneCountInString(string(s[:byteIndex]))
+}
+
+// Contains 检查是否包含子串 str。
+func (s String) Contains(str String) bool {
+	return strings.Contains(string(s), string(str))
+}
+
+// StartsWith 检查是否以 prefix 开头。
+func (s String) StartsWith(prefix String) bool {
+	return strings.HasPrefix(string(s), string(prefix))
+}
+
+// EndsWith 检查是否以 suffix 结尾。
+func (s String) EndsWith(suffix String) bool {
+	return strings.HasSuffix(string(s), string(suffix))
+}
+
+// Concat 返回 s 与 str 连接后的字符串。
+func (s String) Concat(str String) String {
+	return s + str
+}
+
+// Repeat 返回将 s 重复 count 次后的字符串。
+func (s String) Repeat(count int) String {
+	return String(strings.Repeat(string(s), count))
+}
+
+// Split 以正则表达式 regex 的匹配处为界拆分字符串，并去掉末尾的空串，与 Java 的 split(regex) 一致。
+func (s String) Split(regex String) *arraylist.ArrayList[String] {
+	return s.SplitN(regex, 0)
+}
+
+// SplitN 对应 Java 的 split(regex, limit)：limit 大于0时最多拆分为 limit 段，最后一段包含剩余的全部内容；
+// limit 小于0时保留所有段；limit 等于0时与 Split 相同，去掉末尾的空串。
+func (s String) SplitN(regex String, limit int) *arraylist.ArrayList[String] {
+	str := string(s)
+	var parts []string
+	index := 0
+	// 与 Java 的 Pattern.split 相同的遍历方式；regexp.Split 会丢掉末尾零宽匹配之后的空串，因此不直接使用
+	for _, loc := range regexp.MustCompile(string(regex)).FindAllStringIndex(str, -1) {
+		if limit > 0 && len(parts) == limit-1 {
+			break
+		}
+		// 字符串开头的零宽匹配不会产生前导空串，也不计入 limit
+		if index == 0 && loc[0] == 0 && loc[1] == 0 {
+			continue
+		}
+		parts = append(parts, str[index:loc[0]])
+		index = loc[1]
+	}
+	parts = append(parts, str[index:])
+	if limit == 0 && len(parts) > 1 {
+		for len(parts) > 0 && parts[len(parts)-1] == "" {
+			parts = parts[:len(parts)-1]
+		}
+	}
+	list := arraylist.NewArrayListWithCapacity[String](len(parts))
+	for _, part := range parts {
+		list.Add(String(part))
+	}
+	return list
+}
+
+// Replace 将所有子串 target 替换为 replacement，不使用正则表达式。
+func (s String) Replace(target, replacement String) String {
+	return String(strings.ReplaceAll(string(s), string(target), string(replacement)))
+}
+
+// ReplaceAll 将正则表达式 regex 的所有匹配替换为 replacement，replacement 中可以用 $1 或 ${name} 引用分组。
+func (s String) ReplaceAll(regex, replacement String) String {
+	return String(regexp.MustCompile(string(regex)).ReplaceAllString(string(s), string(replacement)))
+}
+
+// ReplaceFirst 将正则表达式 regex 的第一个匹配替换为 replacement，replacement 的语法与 ReplaceAll 相同。
+func (s String) ReplaceFirst(regex, replacement String) String {
+	re := regexp.MustCompile(string(regex))
+	loc := re.FindStringSubmatchIndex(string(s))
+	if loc == nil {
+		return s
+	}
+	replaced := re.ExpandString(nil, string(replacement), string(s), loc)
+	return s[:loc[0]] + String(replaced) + s[loc[1]:]
+}
+
+// Matches 检查整个字符串是否匹配正则表达式 regex。
+func (s String) Matches(regex String) bool {
+	return regexp.MustCompile(`^(?:` + string(regex) + `)$`).MatchString(string(s))
+}
+
+// Trim 去掉首尾所有码值不大于空格（U+0020）的字符，与 Java 的 trim 一致。
+func (s String) Trim() String {
+	return String(strings.TrimFunc(string(s), func(r rune) bool { return r <= ' ' }))
+}
+
+// Strip 去掉首尾的 Unicode 空白字符。
+func (s String) Strip() String {
+	return String(strings.TrimSpace(string(s)))
+}
+
+// StripLeading 去掉开头的 Unicode 空白字符。
+func (s String) StripLeading() String {
+	return String(strings.TrimLeftFunc(string(s), unicode.IsSpace))
+}
+
+// StripTrailing 去掉末尾的 Unicode 空白字符。
+func (s String) StripTrailing() String {
+	return String(strings.TrimRightFunc(string(s), unicode.IsSpace))
+}
+
+// ToUpperCase 返回转换为大写后的字符串。
+func (s String) ToUpperCase() String {
+	return String(strings.ToUpper(string(s)))
+}
+
+// ToLowerCase 返回转换为小写后的字符串。
+func (s String) ToLowerCase() String {
+	return String(strings.ToLower(string(s)))
+}
+
+// EqualsIgnoreCase 忽略大小写比较两个字符串是否相等，与 CompareToIgnoreCase 返回0的条件相同。
+func (s String) EqualsIgnoreCase(other String) bool {
+	return s.CompareToIgnoreCase(other) == 0
+}
+
+// CompareToIgnoreCase 忽略大小写比较两个字符串的顺序，与 Java 一样逐个比较先转大写再转小写后的字符。
+func (s String) CompareToIgnoreCase(other String) int {
+	a, b := []rune(s), []rune(other)
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, y := unicode.ToLower(unicode.ToUpper(a[i])), unicode.ToLower(unicode.ToUpper(b[i]))
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// ToCharArray 返回字符串中所有 rune 组成的切片。
+func (s String) ToCharArray() []rune {
+	return []rune(s)
+}
+
+// javaHashCode 按 Java 的算法 s[0]*31^(n-1) + ... + s[n-1] 在 UTF-16 代码单元上计算哈希码。
+func (s String) javaHashCode() int32 {
+	var h int32
+	for _, r := range s {
+		for _, unit := range utf16.AppendRune(nil, r) {
+			h = 31*h + int32(unit)
+		}
+	}
+	return h
+}
@@ -0,0 +1,144 @@
+package lang
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// expectIndexPanic 检查 f 以包装了 collection.ErrIndexOutOfBounds 的错误panic。
+func expectIndexPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		if err, ok := recover().(error); !ok || !errors.Is(err, collection.ErrIndexOutOfBounds) {
+			t.Errorf("%s: panic = %v, want ErrIndexOutOfBounds", name, err)
+		}
+	}()
+	f()
+}
+
+// 期望值与 Java 的 "...".split(regex, limit) 一致。
+func TestSplitN(t *testing.T) {
+	tests := []struct {
+		s, regex String
+		limit    int
+		want     []String
+	}{
+		{"boo:and:foo", ":", 2, []String{"boo", "and:foo"}},
+		{"boo:and:foo", ":", 5, []String{"boo", "and", "foo"}},
+		{"boo:and:foo", ":", -2, []String{"boo", "and", "foo"}},
+		{"boo:and:foo", "o", 5, []String{"b", "", ":and:f", "", ""}},
+		{"boo:and:foo", "o", -2, []String{"b", "", ":and:f", "", ""}},
+		{"boo:and:foo", "o", 0, []String{"b", "", ":and:f"}},
+		{"boo:and:foo", "o", 1, []String{"boo:and:foo"}},
+		{",a", ",", 0, []String{"", "a"}},
+		{",", ",", 0, []String{}},
+		{",", ",", -1, []String{"", ""}},
+		{"", ",", 0, []String{""}},
+		{"abc", "x", 0, []String{"abc"}},
+		// 开头的零宽匹配不产生前导空串，也不计入 limit
+		{"abc", "", 0, []String{"a", "b", "c"}},
+		{"abc", "", 2, []String{"a", "bc"}},
+		{"abc", "", -1, []String{"a", "b", "c", ""}},
+		{"hello world", `\b`, 0, []String{"hello", " ", "world"}},
+		{"hello world", `\b`, 2, []String{"hello", " world"}},
+		{"hello world", `\b`, -1, []String{"hello", " ", "world", ""}},
+		{"你好😀世界", "", 0, []String{"你", "好", "😀", "世", "界"}},
+		{"你,好,😀", ",", 2, []String{"你", "好,😀"}},
+	}
+	for _, tt := range tests {
+		if got := tt.s.SplitN(tt.regex, tt.limit).ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("String(%q).SplitN(%q, %d) = %q, want %q", tt.s, tt.regex, tt.limit, got, tt.want)
+		}
+	}
+	if got, want := String("a:b::").Split(":").ToSlice(), []String{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Split = %q, want %q", got, want)
+	}
+}
+
+// 索引和长度按 rune 计数，中文和 emoji 都只占一个位置。
+func TestRuneIndexing(t *testing.T) {
+	s := String("你好😀世界😀")
+	if s.Length() != 6 {
+		t.Errorf("Length() = %d, want 6", s.Length())
+	}
+	if s.CharAt(2) != '😀' || s.CharAt(5) != '😀' || s.CharAt(0) != '你' {
+		t.Errorf("CharAt returned %q %q %q", s.CharAt(0), s.CharAt(2), s.CharAt(5))
+	}
+	substrings := []struct {
+		begin, end int
+		want       String
+	}{
+		{0, 2, "你好"},
+		{2, 3, "😀"},
+		{3, 6, "世界😀"},
+		{4, 4, ""},
+		{0, 6, s},
+	}
+	for _, tt := range substrings {
+		if got := s.Substring(tt.begin, tt.end); got != tt.want {
+			t.Errorf("Substring(%d, %d) = %q, want %q", tt.begin, tt.end, got, tt.want)
+		}
+	}
+	if got := s.SubstringFrom(3); got != "世界😀" {
+		t.Errorf("SubstringFrom(3) = %q, want %q", got, "世界😀")
+	}
+
+	indexes := []struct {
+		str         String
+		first, last int
+	}{
+		{"😀", 2, 5},
+		{"世界", 3, 3},
+		{"你", 0, 0},
+		{"", 0, 6},
+		{"😀世", 2, 2},
+		{"a", -1, -1},
+	}
+	for _, tt := range indexes {
+		if got := s.IndexOf(tt.str); got != tt.first {
+			t.Errorf("IndexOf(%q) = %d, want %d", tt.str, got, tt.first)
+		}
+		if got := s.LastIndexOf(tt.str); got != tt.last {
+			t.Errorf("LastIndexOf(%q) = %d, want %d", tt.str, got, tt.last)
+		}
+	}
+
+	expectIndexPanic(t, "CharAt(6)", func() { s.CharAt(6) })
+	expectIndexPanic(t, "CharAt(-1)", func() { s.CharAt(-1) })
+	expectIndexPanic(t, "Substring(0, 7)", func() { s.Substring(0, 7) })
+	expectIndexPanic(t, "Substring(4, 3)", func() { s.Substring(4, 3) })
+	expectIndexPanic(t, "Substring(-1, 2)", func() { s.Substring(-1, 2) })
+	expectIndexPanic(t, "SubstringFrom(7)", func() { s.SubstringFrom(7) })
+}
+
+func TestCompareToIgnoreCase(t *testing.T) {
+	tests := []struct {
+		a, b String
+		want int
+	}{
+		{"abc", "ABC", 0},
+		{"abc", "ABD", -1},
+		{"ABD", "abc", 1},
+		{"ab", "ABC", -1},
+		{"ABC", "ab", 1},
+		{"", "", 0},
+		{"", "a", -1},
+		// 与 Java 相同，先转大写再转小写，因此 ς 和 Σ、İ 和 i 被视为相等
+		{"ς", "Σ", 0},
+		{"İ", "i", 0},
+		{"Straße", "STRASSE", 1},
+		{"你好", "你好", 0},
+	}
+	for _, tt := range tests {
+		if got := sign(tt.a.CompareToIgnoreCase(tt.b)); got != tt.want {
+			t.Errorf("String(%q).CompareToIgnoreCase(%q) = %d, want sign %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := tt.a.EqualsIgnoreCase(tt.b); got != (tt.want == 0) {
+			t.Errorf("String(%q).EqualsIgnoreCase(%q) = %v", tt.a, tt.b, got)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/herry-hu/go-collections-java/collection/list/linkedlist"
 	"github.com/herry-hu/go-collections-java/collection/set/hashset"
 	"github.com/herry-hu/go-collections-java/collection/set/treeset"
+	"github.com/herry-hu/go-collections-java/collections"
 	"github.com/herry-hu/go-collections-java/lang"
 	"github.com/herry-hu/go-collections-java/map/concurrenthashmap"
 	"github.com/herry-hu/go-collections-java/map/hashmap"
@@ -17,16 +18,13 @@ type Person struct {
 	Age  int
 }
 
-func (p Person) CompareTo(other interface{}) int {
-	if otherPerson, ok := other.(Person); ok {
-		if p.Age < otherPerson.Age {
-			return -1
-		} else if p.Age > otherPerson.Age {
-			return 1
-		}
-		return 0
+func (p Person) CompareTo(other Person) int {
+	if p.Age < other.Age {
+		return -1
+	} else if p.Age > other.Age {
+		return 1
 	}
-	panic("Cannot compare different types")
+	return 0
 }
 
 func main() {
@@ -146,12 +144,23 @@ func main() {
 	setc.Add("apple")
 	fmt.Println(setc)
 
-	//treeset:只支持实现了compareTo的类型
+	//treeset:元素类型实现了compareTo时使用 NewTreeSet，否则使用 NewTreeSetFunc 或 NewOrderedTreeSet
 	tree := treeset.NewTreeSet[lang.String]()
 	tree.Add("apple")
 	tree.Add("banana")
 	tree.Add("orange")
 	tree.Add("apple")
 	fmt.Println(tree)
+	personTree := treeset.NewTreeSet[Person]()
+	personTree.AddAll(persons.ToSlice()...)
+	fmt.Println(personTree)
+
+	//comparator:不依赖 CompareTo，按姓名排序、姓名相同时按年龄排序
+	byName := lang.Comparing(func(p Person) string { return p.Name }).
+		ThenComparing(lang.Comparing(func(p Person) int { return p.Age }))
+	collections.SortFunc[Person](persons, byName)
+	fmt.Println(persons)
+	collections.SortFunc[Person](persons, byName.Reversed())
+	fmt.Println(persons)
 
 }
@@ -3,147 +3,200 @@ package concurrenthashmap
 import (
 	"bytes"
 	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/lang"
 	"hash/fnv"
+	"iter"
 	"reflect"
+	"sync"
 	"sync/atomic"
-	"unsafe"
 )
 
+var _ collection.ConcurrentMap[string, int] = (*ConcurrentHashMap[string, int])(nil)
+
 type entry[T comparable, V comparable] struct {
-	key   T              // 键
-	value V              // 值
-	next  unsafe.Pointer // 指向下一个节点的指针
+	key   T                           // 键
+	value atomic.Pointer[V]           // 值
+	next  atomic.Pointer[entry[T, V]] // 指向下一个节点的指针
 }
 
+// ConcurrentHashMap 读操作无锁，写操作通过互斥锁串行化，扩容时整体替换桶数组。
+//
+// 键实现了 lang.Hashable 时按其 Equals 与 HashCode 判断键是否相同，否则使用 == 与内置的哈希函数。
 type ConcurrentHashMap[T comparable, V comparable] struct {
-	data           []*entry[T, V] // 存储数据的切片
-	capacity       int            // 哈希表容量
-	size           int            // 哈希表中元素数量
-	loadFactor     float64        // 负载因子
-	threshold      int            // 下一次扩容的阈值
-	resizeCapacity int            // 扩容后的容量
+	data       atomic.Pointer[[]atomic.Pointer[entry[T, V]]] // 存储数据的桶数组
+	size       atomic.Int64                                  // 哈希表中元素数量
+	loadFactor float64                                       // 负载因子
+	threshold  int                                           // 下一次扩容的阈值
+	lock       sync.Mutex                                    // 用于串行化写操作的互斥锁
 }
 
 // 创建一个新的并发安全的哈希表
 func NewConcurrentHashMap[T comparable, V comparable]() *ConcurrentHashMap[T, V] {
-	return &ConcurrentHashMap[T, V]{
-		data:           make([]*entry[T, V], 16),
-		capacity:       16,
-		size:           0,
-		loadFactor:     0.75,
-		threshold:      12,
-		resizeCapacity: 32,
+	h := &ConcurrentHashMap[T, V]{
+		loadFactor: 0.75,
+		threshold:  12,
 	}
+	data := make([]atomic.Pointer[entry[T, V]], 16)
+	h.data.Store(&data)
+	return h
 }
 
 // 将键值对添加到并发安全的哈希表中
 func (h *ConcurrentHashMap[T, V]) Put(key T, value V) {
-	// 如果负载因子大于阈值，扩容哈希表
-	if float64(h.size)/float64(h.capacity) > h.loadFactor {
-		h.resize()
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if e := h.find(key); e != nil {
+		e.value.Store(&value) // 如果存在相同的键，更新其对应的值
+		return
 	}
+	h.insert(key, value)
+}
 
-	// 计算键的哈希值，并得到对应的索引
-	hash := h.hash(key)
-	index := int(hash & uint32(h.capacity-1))
+// 根据键获取并发安全的哈希表中对应的值
+func (h *ConcurrentHashMap[T, V]) Get(key T) (V, bool) {
+	if e := h.find(key); e != nil {
+		return *e.value.Load(), true // 如果存在相同的键，返回其对应的值和true
+	}
 
-	// 创建一个新的节点
-	newEntry := &entry[T, V]{key: key, value: value}
+	var zeroValue V         // 很关键
+	return zeroValue, false // 如果不存在相同的键，返回值的零值和false
+}
 
-	// 循环直到成功插入节点或找到相同的键
-	for {
-		oldEntry := (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index]))))
+// 根据键获取对应的值，键不存在时返回包装了 collection.ErrNoSuchElement 的错误
+func (h *ConcurrentHashMap[T, V]) TryGet(key T) (V, error) {
+	if value, found := h.Get(key); found {
+		return value, nil
+	}
+	var zeroValue V
+	return zeroValue, fmt.Errorf("%w: key %v", collection.ErrNoSuchElement, key)
+}
 
-		// 如果该索引对应的节点为nil，直接将新节点插入
-		if oldEntry == nil {
-			if atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index])), unsafe.Pointer(oldEntry), unsafe.Pointer(newEntry)) {
-				atomic.AddInt32((*int32)(unsafe.Pointer(&h.size)), 1)
-				return
-			}
-		}
+// 根据键获取对应的值并包装为 lang.Optional，键不存在时返回 lang.Empty
+func (h *ConcurrentHashMap[T, V]) GetOpt(key T) lang.Optional[V] {
+	return lang.OfOk(h.Get(key))
+}
 
-		// 遍历该索引对应的链表，查找是否存在相同的键
-		for e := oldEntry; e != nil; e = (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&e.next)))) {
-			if e.key == key {
-				// 如果存在相同的键，更新其对应的值
-				atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&e.value)), unsafe.Pointer(&value))
-				return
-			}
-		}
+// 检查并发安全的哈希表中是否包含指定的键
+func (h *ConcurrentHashMap[T, V]) ContainsKey(key T) bool {
+	return h.find(key) != nil
+}
 
-		// 将新节点插入链表的头部
-		newEntry.next = unsafe.Pointer(oldEntry)
-		if atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index])), unsafe.Pointer(oldEntry), unsafe.Pointer(newEntry)) {
-			atomic.AddInt32((*int32)(unsafe.Pointer(&h.size)), 1)
-			return
-		}
+// 删除并发安全的哈希表中指定键的键值对
+func (h *ConcurrentHashMap[T, V]) Delete(key T) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.unlink(key, func(*entry[T, V]) bool { return true })
+}
+
+// 仅在键不存在时写入键值对，键已存在时返回其当前值和true
+func (h *ConcurrentHashMap[T, V]) PutIfAbsent(key T, value V) (V, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if e := h.find(key); e != nil {
+		return *e.value.Load(), true
 	}
+	h.insert(key, value)
+	var zeroValue V
+	return zeroValue, false
 }
 
-// 根据键获取并发安全的哈希表中对应的值
-func (h *ConcurrentHashMap[T, V]) Get(key T) (V, bool) {
-	// 计算键的哈希值，并得到对应的索引
-	hash := h.hash(key)
-	index := int(hash & uint32(h.capacity-1))
+// 仅在键存在时替换其对应的值，返回旧值和true
+func (h *ConcurrentHashMap[T, V]) Replace(key T, value V) (V, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
 
-	// 遍历该索引对应的链表，查找是否存在相同的键
-	for e := (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index])))); e != nil; e = (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&e.next)))) {
-		if e.key == key {
-			return e.value, true // 如果存在相同的键，返回其对应的值和true
-		}
+	if e := h.find(key); e != nil {
+		return *e.value.Swap(&value), true
 	}
+	var zeroValue V
+	return zeroValue, false
+}
 
-	var zeroValue V         // 很关键
-	return zeroValue, false // 如果不存在相同的键，返回值的零值和false
+// 仅在键当前对应的值等于old时将其替换为new，值实现了 lang.Hashable 时按其 Equals 比较
+func (h *ConcurrentHashMap[T, V]) CompareAndReplace(key T, old V, new V) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if e := h.find(key); e != nil && collection.Equal(*e.value.Load(), old) {
+		e.value.Store(&new)
+		return true
+	}
+	return false
 }
 
-// 删除并发安全的哈希表中指定键的键值对
-func (h *ConcurrentHashMap[T, V]) Delete(key T) bool {
-	// 计算键的哈希值，并得到对应的索引
-	hash := h.hash(key)
-	index := int(hash & uint32(h.capacity-1))
+// 仅在键当前对应的值等于value时删除该键值对，值实现了 lang.Hashable 时按其 Equals 比较
+func (h *ConcurrentHashMap[T, V]) CompareAndDelete(key T, value V) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
 
-	// 循环直到成功删除节点或找不到相同的键
-	for {
-		oldEntry := (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index]))))
+	return h.unlink(key, func(e *entry[T, V]) bool { return collection.Equal(*e.value.Load(), value) })
+}
 
-		// 如果该索引对应的节点为nil，直接返回false
-		if oldEntry == nil {
-			return false
-		}
+// 查找键对应的节点，读路径只使用原子加载，不加锁
+func (h *ConcurrentHashMap[T, V]) find(key T) *entry[T, V] {
+	data := *h.data.Load()
+	index := h.indexFor(key, len(data))
 
-		var prev *entry[T, V] = nil
-		var found bool = false
-
-		// 遍历该索引对应的链表，查找是否存在相同的键，并删除其对应的节点
-		for e := oldEntry; e != nil; e = (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&e.next)))) {
-			if e.key == key {
-				found = true
-				if prev == nil {
-					// 如果要删除的节点是链表的头节点
-					if atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[index])), unsafe.Pointer(oldEntry), unsafe.Pointer(e.next)) {
-						atomic.AddInt32((*int32)(unsafe.Pointer(&h.size)), -1)
-						return true
-					}
-				} else {
-					// 如果要删除的节点不是链表的头节点
-					if atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&prev.next)), unsafe.Pointer(e), unsafe.Pointer(e.next)) {
-						atomic.AddInt32((*int32)(unsafe.Pointer(&h.size)), -1)
-						return true
-					}
-				}
-			}
-			prev = e
+	// 遍历该索引对应的链表，查找是否存在相同的键
+	for e := data[index].Load(); e != nil; e = e.next.Load() {
+		if collection.Equal(key, e.key) {
+			return e
 		}
+	}
+	return nil
+}
 
-		if !found {
-			return false
+// 将新节点插入链表的头部，调用方必须持有写锁且已确认键不存在
+func (h *ConcurrentHashMap[T, V]) insert(key T, value V) {
+	data := *h.data.Load()
+	index := h.indexFor(key, len(data))
+
+	newEntry := &entry[T, V]{key: key}
+	newEntry.value.Store(&value)
+	newEntry.next.Store(data[index].Load())
+	data[index].Store(newEntry)
+
+	// 如果元素数量超过阈值，扩容哈希表
+	if int(h.size.Add(1)) > h.threshold {
+		h.resize()
+	}
+}
+
+// 删除键对应且满足match的节点，调用方必须持有写锁
+func (h *ConcurrentHashMap[T, V]) unlink(key T, match func(*entry[T, V]) bool) bool {
+	data := *h.data.Load()
+	index := h.indexFor(key, len(data))
+
+	// 被摘除节点的next保持不变，正在遍历它的读者仍能继续走完链表
+	var prev *entry[T, V]
+	for e := data[index].Load(); e != nil; e = e.next.Load() {
+		if collection.Equal(key, e.key) {
+			if !match(e) {
+				return false
+			}
+			if prev == nil {
+				data[index].Store(e.next.Load())
+			} else {
+				prev.next.Store(e.next.Load())
+			}
+			h.size.Add(-1)
+			return true
 		}
+		prev = e
 	}
+	return false
 }
 
 // 计算键的哈希值
 func (h *ConcurrentHashMap[T, V]) hash(key T) uint32 {
+	// 键实现了 lang.Hashable 时使用其 HashCode，高32位折叠进低32位
+	if code, ok := lang.HashCodeOf(key); ok {
+		return uint32(code ^ code>>32)
+	}
 	switch reflect.TypeOf(key).Kind() {
 	case reflect.Int:
 		return uint32(reflect.ValueOf(key).Int())
@@ -161,50 +214,195 @@ func (h *ConcurrentHashMap[T, V]) hash(key T) uint32 {
 	}
 }
 
-// 扩容并发安全的哈希表
+// 扩容并发安全的哈希表，复制出新的桶数组后整体替换，正在读旧数组的读者不受影响
 func (h *ConcurrentHashMap[T, V]) resize() {
-	h.capacity = h.resizeCapacity
-	h.resizeCapacity *= 2
-
-	newData := make([]*entry[T, V], h.capacity)
+	oldData := *h.data.Load()
+	newData := make([]atomic.Pointer[entry[T, V]], len(oldData)*2)
 
-	for i := 0; i < len(h.data); i++ {
-		for e := h.data[i]; e != nil; e = (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&e.next)))) {
-			index := h.getIndex(e.key)
-			if newData[index] == nil {
-				newData[index] = &entry[T, V]{e.key, e.value, nil}
-			} else {
-				newData[index].next = unsafe.Pointer(e)
-			}
+	for i := range oldData {
+		for e := oldData[i].Load(); e != nil; e = e.next.Load() {
+			index := h.indexFor(e.key, len(newData))
+			copied := &entry[T, V]{key: e.key}
+			copied.value.Store(e.value.Load())
+			copied.next.Store(newData[index].Load())
+			newData[index].Store(copied)
 		}
 	}
 
-	h.data = newData
+	h.threshold = int(float64(len(newData)) * h.loadFactor)
+	h.data.Store(&newData)
 }
 
-// 计算键在并发安全的哈希表中对应的索引
-func (h *ConcurrentHashMap[T, V]) getIndex(key T) int {
-	hash := h.hash(key)
-	return int(hash & uint32(h.capacity-1))
+// 计算键在容量为capacity的桶数组中对应的索引
+func (h *ConcurrentHashMap[T, V]) indexFor(key T, capacity int) int {
+	return int(h.hash(key) & uint32(capacity-1))
 }
 
 // 实现fmt.Stringer接口，将并发安全的哈希表转换为字符串表示形式
 func (h *ConcurrentHashMap[T, V]) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("{")
-	for i := 0; i < h.capacity; i++ {
-		for e := (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&h.data[i])))); e != nil; e = (*entry[T, V])(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&e.next)))) {
-			if buf.Len() > 1 {
-				buf.WriteString(", ")
-			}
-			buf.WriteString(fmt.Sprintf("%v: %v", e.key, e.value))
+	h.ForEach(func(key T, value V) {
+		if buf.Len() > 1 {
+			buf.WriteString(", ")
 		}
-	}
+		buf.WriteString(fmt.Sprintf("%v: %v", key, value))
+	})
 	buf.WriteString("}")
 	return buf.String()
 }
 
+// 检查 other 是否包含完全相同的键值对，other 可以是任意 collection.Map 实现；并发修改期间比较的结果是弱一致的
+func (h *ConcurrentHashMap[T, V]) Equals(other collection.Map[T, V]) bool {
+	if other == nil || h.Size() != other.Size() {
+		return false
+	}
+	for key, value := range h.All() {
+		otherValue, found := other.Get(key)
+		if !found || !collection.Equal(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// 按 Java 的 Map.hashCode 计算哈希码，即所有键值对中键与值的哈希码异或后之和
+func (h *ConcurrentHashMap[T, V]) HashCode() uint64 {
+	var hash uint64
+	for key, value := range h.All() {
+		hash += collection.HashCode(key) ^ collection.HashCode(value)
+	}
+	return hash
+}
+
 // 返回并发安全的哈希表中元素的数量
 func (h *ConcurrentHashMap[T, V]) Size() int {
-	return int(atomic.LoadInt32((*int32)(unsafe.Pointer(&h.size))))
+	return int(h.size.Load())
+}
+
+// 检查并发安全的哈希表是否为空
+func (h *ConcurrentHashMap[T, V]) IsEmpty() bool {
+	return h.Size() == 0
+}
+
+// 清空并发安全的哈希表中的所有元素
+func (h *ConcurrentHashMap[T, V]) Clear() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	data := make([]atomic.Pointer[entry[T, V]], len(*h.data.Load()))
+	h.data.Store(&data)
+	h.size.Store(0)
+}
+
+// 遍历哈希表中的所有元素，并对每个元素执行指定的操作；遍历期间的并发修改可能可见也可能不可见
+func (h *ConcurrentHashMap[T, V]) ForEach(fn func(key T, value V)) {
+	data := *h.data.Load()
+	for i := range data {
+		for e := data[i].Load(); e != nil; e = e.next.Load() {
+			fn(e.key, *e.value.Load())
+		}
+	}
+}
+
+// 返回遍历哈希表中所有键的迭代器，迭代器是弱一致的，不会因并发修改而失败
+func (h *ConcurrentHashMap[T, V]) KeyIterator() collection.Iterator[T] {
+	return newIterator(h, func(e *entry[T, V]) T { return e.key })
+}
+
+// 返回遍历哈希表中所有值的迭代器，迭代器是弱一致的，不会因并发修改而失败
+func (h *ConcurrentHashMap[T, V]) ValueIterator() collection.Iterator[V] {
+	return newIterator(h, func(e *entry[T, V]) V { return *e.value.Load() })
+}
+
+// 返回遍历哈希表中所有键值对的迭代器，迭代器是弱一致的，不会因并发修改而失败
+func (h *ConcurrentHashMap[T, V]) EntryIterator() collection.Iterator[collection.Entry[T, V]] {
+	return newIterator(h, func(e *entry[T, V]) collection.Entry[T, V] {
+		return collection.Entry[T, V]{Key: e.key, Value: *e.value.Load()}
+	})
+}
+
+// 遍历创建时的桶数组快照的迭代器，project 决定每个节点产出键、值还是键值对
+type iterator[T comparable, V comparable, R any] struct {
+	h       *ConcurrentHashMap[T, V]      // 被遍历的哈希表
+	data    []atomic.Pointer[entry[T, V]] // 创建迭代器时的桶数组
+	bucket  int                           // 下一个待访问的桶
+	next    *entry[T, V]                  // 下一次 Next 返回的节点
+	last    *entry[T, V]                  // 最近一次 Next 返回的节点
+	project func(e *entry[T, V]) R        // 将节点转换为迭代结果
+}
+
+func newIterator[T comparable, V comparable, R any](h *ConcurrentHashMap[T, V], project func(e *entry[T, V]) R) *iterator[T, V, R] {
+	it := &iterator[T, V, R]{h: h, data: *h.data.Load(), project: project}
+	it.advance()
+	return it
+}
+
+// 定位到下一个非空节点
+func (it *iterator[T, V, R]) advance() {
+	for it.next == nil && it.bucket < len(it.data) {
+		it.next = it.data[it.bucket].Load()
+		it.bucket++
+	}
+}
+
+// 判断是否还有下一个元素
+func (it *iterator[T, V, R]) HasNext() bool {
+	return it.next != nil
+}
+
+// 返回下一个元素
+func (it *iterator[T, V, R]) Next() R {
+	if it.next == nil {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.last = it.next
+	it.next = it.next.next.Load()
+	it.advance()
+	return it.project(it.last)
+}
+
+// 删除最近一次 Next 返回的键值对
+func (it *iterator[T, V, R]) Remove() {
+	if it.last == nil {
+		panic(collection.ErrIllegalState)
+	}
+	it.h.Delete(it.last.key)
+	it.last = nil
+}
+
+// 返回产出哈希表中所有键值对的序列，遍历期间的并发修改可能可见也可能不可见
+func (h *ConcurrentHashMap[T, V]) All() iter.Seq2[T, V] {
+	return func(yield func(T, V) bool) {
+		data := *h.data.Load()
+		for i := range data {
+			for e := data[i].Load(); e != nil; e = e.next.Load() {
+				if !yield(e.key, *e.value.Load()) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// 返回产出哈希表中所有键的序列
+func (h *ConcurrentHashMap[T, V]) Keys() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for key := range h.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// 返回产出哈希表中所有值的序列
+func (h *ConcurrentHashMap[T, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range h.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
 }
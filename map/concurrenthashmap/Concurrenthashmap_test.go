@@ -0,0 +1,241 @@
+package concurrenthashmap
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/lang"
+)
+
+func TestPutGetDelete(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("a", 3)
+
+	if v, ok := m.Get("a"); !ok || v != 3 {
+		t.Fatalf("Get(a) = %v, %v, want 3, true", v, ok)
+	}
+	if m.Size() != 2 {
+		t.Fatalf("Size() = %d, want 2", m.Size())
+	}
+	if !m.Delete("a") || m.Delete("a") {
+		t.Fatal("Delete(a) should succeed exactly once")
+	}
+	if m.ContainsKey("a") || !m.ContainsKey("b") {
+		t.Fatal("ContainsKey reports the wrong keys after Delete")
+	}
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Fatalf("IsEmpty() = false after Clear, size %d", m.Size())
+	}
+}
+
+func TestCompoundOperations(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+
+	if _, found := m.PutIfAbsent("k", 1); found {
+		t.Fatal("PutIfAbsent on a missing key reported an existing value")
+	}
+	if v, found := m.PutIfAbsent("k", 2); !found || v != 1 {
+		t.Fatalf("PutIfAbsent on an existing key = %v, %v, want 1, true", v, found)
+	}
+	if _, found := m.Replace("missing", 1); found || m.ContainsKey("missing") {
+		t.Fatal("Replace must not insert a missing key")
+	}
+	if old, found := m.Replace("k", 5); !found || old != 1 {
+		t.Fatalf("Replace = %v, %v, want 1, true", old, found)
+	}
+	if m.CompareAndReplace("k", 1, 6) {
+		t.Fatal("CompareAndReplace succeeded with a stale old value")
+	}
+	if !m.CompareAndReplace("k", 5, 6) {
+		t.Fatal("CompareAndReplace failed with the current value")
+	}
+	if m.CompareAndDelete("k", 5) || !m.ContainsKey("k") {
+		t.Fatal("CompareAndDelete removed a key with a different value")
+	}
+	if !m.CompareAndDelete("k", 6) || m.ContainsKey("k") {
+		t.Fatal("CompareAndDelete did not remove a key with the current value")
+	}
+}
+
+func TestResizeKeepsEntries(t *testing.T) {
+	const n = 10000
+	m := NewConcurrentHashMap[int, int]()
+	for i := 0; i < n; i++ {
+		m.Put(i, i*2)
+	}
+	if m.Size() != n {
+		t.Fatalf("Size() = %d, want %d", m.Size(), n)
+	}
+	for i := 0; i < n; i++ {
+		if v, ok := m.Get(i); !ok || v != i*2 {
+			t.Fatalf("Get(%d) = %v, %v, want %d, true", i, v, ok, i*2)
+		}
+	}
+	count := 0
+	m.ForEach(func(key, value int) {
+		count++
+	})
+	if count != n {
+		t.Fatalf("ForEach visited %d entries, want %d", count, n)
+	}
+}
+
+// 读者与写者并发运行：已经写入的键在扩容期间和之后都必须能读到。
+func TestConcurrentReadsDuringResize(t *testing.T) {
+	const n = 20000
+	m := NewConcurrentHashMap[int, int]()
+	var published atomic.Int64 // 已经写入完成的键的数量
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			m.Put(i, i)
+			published.Store(int64(i + 1))
+		}
+	}()
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				limit := int(published.Load())
+				for i := 0; i < limit; i += 97 {
+					if v, ok := m.Get(i); !ok || v != i {
+						t.Errorf("Get(%d) = %v, %v while resizing", i, v, ok)
+						return
+					}
+				}
+				if limit == n {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// 并发的 PutIfAbsent 只能有一个成功，CompareAndReplace 不能丢失更新。
+func TestConcurrentCompoundOperationsAreAtomic(t *testing.T) {
+	const goroutines, increments = 8, 1000
+	m := NewConcurrentHashMap[string, int]()
+	var winners atomic.Int32
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, found := m.PutIfAbsent("counter", 0); !found {
+				winners.Add(1)
+			}
+			for i := 0; i < increments; i++ {
+				for {
+					v, _ := m.Get("counter")
+					if m.CompareAndReplace("counter", v, v+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if winners.Load() != 1 {
+		t.Fatalf("%d goroutines won PutIfAbsent, want 1", winners.Load())
+	}
+	if v, _ := m.Get("counter"); v != goroutines*increments {
+		t.Fatalf("counter = %d, want %d", v, goroutines*increments)
+	}
+}
+
+// BigDecimal 内部持有指针，数值相等的两个实例用 == 比较并不相等，CompareAndReplace 与 CompareAndDelete 必须使用 Equals。
+func TestCompareOperationsUseEquals(t *testing.T) {
+	parse := func(s string) lang.BigDecimal {
+		d, err := lang.ParseBigDecimal(s)
+		if err != nil {
+			t.Fatalf("ParseBigDecimal(%q): %v", s, err)
+		}
+		return d
+	}
+	m := NewConcurrentHashMap[string, lang.BigDecimal]()
+	m.Put("price", parse("1.50"))
+
+	if m.CompareAndReplace("price", parse("1.5"), parse("2")) {
+		t.Fatal("CompareAndReplace matched 1.5 against 1.50, which differ in scale")
+	}
+	if !m.CompareAndReplace("price", parse("1.50"), parse("2.00")) {
+		t.Fatal("CompareAndReplace did not match an equal BigDecimal")
+	}
+	if v, _ := m.Get("price"); v.String() != "2.00" {
+		t.Fatalf("Get(price) = %v, want 2.00", v)
+	}
+	if m.CompareAndDelete("price", parse("2")) {
+		t.Fatal("CompareAndDelete matched 2 against 2.00")
+	}
+	if !m.CompareAndDelete("price", parse("2.00")) || m.ContainsKey("price") {
+		t.Fatal("CompareAndDelete did not remove an equal BigDecimal")
+	}
+}
+
+// caseInsensitive 是忽略大小写比较的字符串，实现了 lang.Hashable。
+type caseInsensitive string
+
+func (s caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+func (s caseInsensitive) HashCode() uint64 {
+	return lang.String(strings.ToLower(string(s))).HashCode()
+}
+
+// 键实现了 lang.Hashable 时按 Equals 与 HashCode 判断键是否相同，扩容后仍然如此。
+func TestHashableKeys(t *testing.T) {
+	m := NewConcurrentHashMap[caseInsensitive, int]()
+	m.Put("Go", 1)
+	m.Put("GO", 2)
+	if m.Size() != 1 {
+		t.Fatalf("Size() = %d, want 1", m.Size())
+	}
+	if v, ok := m.Get("go"); !ok || v != 2 {
+		t.Fatalf("Get(go) = %v, %v, want 2, true", v, ok)
+	}
+	// 与 Java 一样，更新已有键的值时保留原来的键
+	for key := range m.Keys() {
+		if key != "Go" {
+			t.Fatalf("key = %q, want the first inserted key Go", key)
+		}
+	}
+
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	for i := 0; i < 1000; i++ {
+		m.Put(caseInsensitive(fmt.Sprintf("%s-%d", words[i%len(words)], i)), i)
+	}
+	for i := 0; i < 1000; i++ {
+		key := caseInsensitive(strings.ToUpper(fmt.Sprintf("%s-%d", words[i%len(words)], i)))
+		if v, ok := m.Get(key); !ok || v != i {
+			t.Fatalf("Get(%s) = %v, %v, want %d, true", key, v, ok, i)
+		}
+	}
+	if !m.ContainsKey("gO") || !m.Delete("gO") || m.ContainsKey("Go") || m.Delete("go") {
+		t.Fatal("ContainsKey/Delete ignore Equals")
+	}
+	if m.Size() != 1000 {
+		t.Fatalf("Size() = %d, want 1000", m.Size())
+	}
+
+	upper := NewConcurrentHashMap[caseInsensitive, int]()
+	for key, value := range m.All() {
+		upper.Put(caseInsensitive(strings.ToUpper(string(key))), value)
+	}
+	if !m.Equals(upper) || !upper.Equals(m) || m.HashCode() != upper.HashCode() {
+		t.Fatal("maps whose keys differ only in case are not equal")
+	}
+}
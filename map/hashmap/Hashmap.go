@@ -3,16 +3,24 @@ package hashmap
 import (
 	"bytes"
 	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/lang"
 	"hash/fnv"
+	"iter"
 	"reflect"
 )
 
+var _ collection.Map[string, int] = (*HashMap[string, int])(nil)
+
 type entry[T comparable, V comparable] struct {
 	key   T            // 键
 	value V            // 值
 	next  *entry[T, V] // 指向下一个节点的指针
 }
 
+// HashMap 是基于拉链法的哈希表，不是并发安全的。
+//
+// 键实现了 lang.Hashable 时按其 Equals 与 HashCode 判断键是否相同，否则使用 == 与内置的哈希函数。
 type HashMap[T comparable, V comparable] struct {
 	data           []*entry[T, V] // 存储数据的切片
 	capacity       int            // 哈希表容量
@@ -20,6 +28,7 @@ type HashMap[T comparable, V comparable] struct {
 	loadFactor     float64        // 负载因子
 	threshold      int            // 下一次扩容的阈值
 	resizeCapacity int            // 扩容后的容量
+	modCount       int            // 结构性修改次数，用于迭代时的快速失败检测
 }
 
 // 创建一个新的哈希表
@@ -36,33 +45,28 @@ func NewHashMap[T comparable, V comparable]() *HashMap[T, V] {
 
 // 将键值对添加到哈希表中
 func (h *HashMap[T, V]) Put(key T, value V) {
-	// 如果负载因子大于阈值，扩容哈希表
-	if float64(h.size)/float64(h.capacity) > h.loadFactor {
-		h.resize()
-	}
-
 	// 计算键的哈希值，并得到对应的索引
 	hash := h.hash(key)
 	index := int(hash & uint32(h.capacity-1))
 
-	// 如果该索引对应的节点为nil，创建一个新的节点
-	if h.data[index] == nil {
-		h.data[index] = &entry[T, V]{key, value, nil}
-		h.size++
-		return
-	}
-
-	// 遍历该索引对应的链表或红黑树，查找是否存在相同的键
+	// 遍历该索引对应的链表，查找是否存在相同的键
 	for e := h.data[index]; e != nil; e = e.next {
-		if e.key == key {
+		if collection.Equal(key, e.key) {
 			e.value = value // 如果存在相同的键，更新其对应的值
 			return
 		}
 	}
 
-	// 如果不存在相同的键，将键值对添加到该索引对应的链表或红黑树中
-	h.data[index].next = &entry[T, V]{key, value, nil}
+	// 只有插入新键时才扩容，更新已有键不会改变哈希表的结构
+	if float64(h.size)/float64(h.capacity) > h.loadFactor {
+		h.resize()
+		index = int(hash & uint32(h.capacity-1))
+	}
+
+	// 如果不存在相同的键，将键值对插入到该索引对应链表的头部
+	h.data[index] = &entry[T, V]{key, value, h.data[index]}
 	h.size++
+	h.modCount++
 }
 
 // 根据键获取哈希表中对应的值
@@ -73,7 +77,7 @@ func (h *HashMap[T, V]) Get(key T) (V, bool) {
 
 	// 遍历该索引对应的链表或红黑树，查找是否存在相同的键
 	for e := h.data[index]; e != nil; e = e.next {
-		if e.key == key {
+		if collection.Equal(key, e.key) {
 			return e.value, true // 如果存在相同的键，返回其对应的值和true
 		}
 	}
@@ -82,6 +86,26 @@ func (h *HashMap[T, V]) Get(key T) (V, bool) {
 	return zeroValue, false // 如果不存在相同的键，返回值的零值和false
 }
 
+// 根据键获取对应的值，键不存在时返回包装了 collection.ErrNoSuchElement 的错误
+func (h *HashMap[T, V]) TryGet(key T) (V, error) {
+	if value, found := h.Get(key); found {
+		return value, nil
+	}
+	var zeroValue V
+	return zeroValue, fmt.Errorf("%w: key %v", collection.ErrNoSuchElement, key)
+}
+
+// 根据键获取对应的值并包装为 lang.Optional，键不存在时返回 lang.Empty
+func (h *HashMap[T, V]) GetOpt(key T) lang.Optional[V] {
+	return lang.OfOk(h.Get(key))
+}
+
+// 检查哈希表中是否包含指定的键
+func (h *HashMap[T, V]) ContainsKey(key T) bool {
+	_, found := h.Get(key)
+	return found
+}
+
 // 删除哈希表中指定键的键值对
 func (h *HashMap[T, V]) Delete(key T) bool {
 	// 计算键的哈希值，并得到对应的索引
@@ -91,13 +115,14 @@ func (h *HashMap[T, V]) Delete(key T) bool {
 	// 遍历该索引对应的链表或红黑树，查找是否存在相同的键，并删除其对应的节点
 	prev := h.data[index]
 	for e := h.data[index]; e != nil; e = e.next {
-		if e.key == key {
+		if collection.Equal(key, e.key) {
 			if prev == e {
 				h.data[index] = e.next
 			} else {
 				prev.next = e.next
 			}
 			h.size--
+			h.modCount++
 			return true // 如果存在相同的键，删除其对应的节点并返回true
 		}
 		prev = e
@@ -108,6 +133,10 @@ func (h *HashMap[T, V]) Delete(key T) bool {
 
 // 计算键的哈希值
 func (h *HashMap[T, V]) hash(key T) uint32 {
+	// 键实现了 lang.Hashable 时使用其 HashCode，高32位折叠进低32位
+	if code, ok := lang.HashCodeOf(key); ok {
+		return uint32(code ^ code>>32)
+	}
 	switch reflect.TypeOf(key).Kind() {
 	case reflect.Int:
 		return uint32(reflect.ValueOf(key).Int())
@@ -149,6 +178,7 @@ func (h *HashMap[T, V]) resize() {
 	}
 
 	h.data = newData
+	h.modCount++
 }
 
 // 实现fmt.Stringer接口，将哈希表转换为字符串表示形式
@@ -167,6 +197,29 @@ func (h *HashMap[T, V]) String() string {
 	return buf.String()
 }
 
+// 检查 other 是否包含完全相同的键值对，other 可以是任意 collection.Map 实现
+func (h *HashMap[T, V]) Equals(other collection.Map[T, V]) bool {
+	if other == nil || h.Size() != other.Size() {
+		return false
+	}
+	for key, value := range h.All() {
+		otherValue, found := other.Get(key)
+		if !found || !collection.Equal(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// 按 Java 的 Map.hashCode 计算哈希码，即所有键值对中键与值的哈希码异或后之和
+func (h *HashMap[T, V]) HashCode() uint64 {
+	var hash uint64
+	for key, value := range h.All() {
+		hash += collection.HashCode(key) ^ collection.HashCode(value)
+	}
+	return hash
+}
+
 // 返回哈希表中元素的数量
 func (h *HashMap[T, V]) Size() int {
 	return h.size
@@ -176,6 +229,7 @@ func (h *HashMap[T, V]) Size() int {
 func (h *HashMap[T, V]) Clear() {
 	h.data = make([]*entry[T, V], h.capacity)
 	h.size = 0
+	h.modCount++
 }
 
 // 检查哈希表是否为空
@@ -183,11 +237,219 @@ func (h *HashMap[T, V]) IsEmpty() bool {
 	return h.size == 0
 }
 
-// 遍历哈希表中的所有元素，并对每个元素执行指定的操作
+// 遍历哈希表中的所有元素，并对每个元素执行指定的操作；fn 中增删键值对会以 collection.ErrConcurrentModification panic
 func (h *HashMap[T, V]) ForEach(fn func(key T, value V)) {
-	for i := 0; i < h.capacity; i++ {
-		for e := h.data[i]; e != nil; e = e.next {
-			fn(e.key, e.value)
+	for key, value := range h.All() {
+		fn(key, value)
+	}
+}
+
+// 返回遍历哈希表中所有键的迭代器
+func (h *HashMap[T, V]) KeyIterator() collection.Iterator[T] {
+	return newIterator(h, func(e *entry[T, V]) T { return e.key })
+}
+
+// 返回遍历哈希表中所有值的迭代器
+func (h *HashMap[T, V]) ValueIterator() collection.Iterator[V] {
+	return newIterator(h, func(e *entry[T, V]) V { return e.value })
+}
+
+// 返回遍历哈希表中所有键值对的迭代器
+func (h *HashMap[T, V]) EntryIterator() collection.Iterator[collection.Entry[T, V]] {
+	return newIterator(h, func(e *entry[T, V]) collection.Entry[T, V] {
+		return collection.Entry[T, V]{Key: e.key, Value: e.value}
+	})
+}
+
+// 按桶的顺序遍历哈希表的迭代器，project 决定每个节点产出键、值还是键值对
+type iterator[T comparable, V comparable, R any] struct {
+	h                *HashMap[T, V]         // 被遍历的哈希表
+	bucket           int                    // 下一个待访问的桶
+	next             *entry[T, V]           // 下一次 Next 返回的节点
+	last             *entry[T, V]           // 最近一次 Next 返回的节点
+	project          func(e *entry[T, V]) R // 将节点转换为迭代结果
+	expectedModCount int                    // 迭代器认可的哈希表修改次数
+}
+
+func newIterator[T comparable, V comparable, R any](h *HashMap[T, V], project func(e *entry[T, V]) R) *iterator[T, V, R] {
+	it := &iterator[T, V, R]{h: h, project: project, expectedModCount: h.modCount}
+	it.advance()
+	return it
+}
+
+// 定位到下一个非空节点
+func (it *iterator[T, V, R]) advance() {
+	for it.next == nil && it.bucket < len(it.h.data) {
+		it.next = it.h.data[it.bucket]
+		it.bucket++
+	}
+}
+
+// 判断是否还有下一个元素
+func (it *iterator[T, V, R]) HasNext() bool {
+	return it.next != nil
+}
+
+// 检查哈希表是否在迭代器之外被结构性修改
+func (it *iterator[T, V, R]) checkForComodification() {
+	if it.h.modCount != it.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+}
+
+// 返回下一个元素
+func (it *iterator[T, V, R]) Next() R {
+	it.checkForComodification()
+	if it.next == nil {
+		panic(collection.ErrNoSuchElement)
+	}
+	it.last = it.next
+	it.next = it.next.next
+	it.advance()
+	return it.project(it.last)
+}
+
+// 删除最近一次 Next 返回的键值对
+func (it *iterator[T, V, R]) Remove() {
+	if it.last == nil {
+		panic(collection.ErrIllegalState)
+	}
+	it.checkForComodification()
+	it.h.Delete(it.last.key)
+	it.expectedModCount = it.h.modCount
+	it.last = nil
+}
+
+// 返回产出哈希表中所有键值对的序列
+func (h *HashMap[T, V]) All() iter.Seq2[T, V] {
+	return func(yield func(T, V) bool) {
+		expectedModCount := h.modCount
+		for i := 0; i < h.capacity; i++ {
+			for e := h.data[i]; e != nil; e = e.next {
+				if !yield(e.key, e.value) {
+					return
+				}
+				if h.modCount != expectedModCount {
+					panic(collection.ErrConcurrentModification)
+				}
+			}
+		}
+	}
+}
+
+// 返回产出哈希表中所有键的序列
+func (h *HashMap[T, V]) Keys() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for key := range h.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// 返回产出哈希表中所有值的序列
+func (h *HashMap[T, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range h.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// 返回按桶拆分哈希表中所有键的 Spliterator
+func (h *HashMap[T, V]) KeySpliterator() collection.Spliterator[T] {
+	return newSpliterator(h, collection.Distinct, func(e *entry[T, V]) T { return e.key })
+}
+
+// 返回按桶拆分哈希表中所有值的 Spliterator
+func (h *HashMap[T, V]) ValueSpliterator() collection.Spliterator[V] {
+	return newSpliterator(h, 0, func(e *entry[T, V]) V { return e.value })
+}
+
+// 返回按桶拆分哈希表中所有键值对的 Spliterator
+func (h *HashMap[T, V]) EntrySpliterator() collection.Spliterator[collection.Entry[T, V]] {
+	return newSpliterator(h, collection.Distinct, func(e *entry[T, V]) collection.Entry[T, V] {
+		return collection.Entry[T, V]{Key: e.key, Value: e.value}
+	})
+}
+
+// 负责桶区间 [bucket, fence) 的 Spliterator，拆分时把桶区间对半分
+type spliterator[T comparable, V comparable, R any] struct {
+	h                *HashMap[T, V]             // 被遍历的哈希表
+	bucket           int                        // 下一个待访问的桶
+	fence            int                        // 桶区间的上界（不含）
+	current          *entry[T, V]               // 当前桶中下一个待访问的节点
+	est              int                        // 剩余元素数量的估计值
+	split            bool                       // 是否由拆分得到，拆分后元素数量不再精确
+	flags            collection.Characteristics // 与元素类型相关的特征
+	project          func(e *entry[T, V]) R     // 将节点转换为遍历结果
+	expectedModCount int                        // 认可的哈希表修改次数
+}
+
+func newSpliterator[T comparable, V comparable, R any](h *HashMap[T, V], flags collection.Characteristics, project func(e *entry[T, V]) R) *spliterator[T, V, R] {
+	return &spliterator[T, V, R]{
+		h:                h,
+		fence:            len(h.data),
+		est:              h.size,
+		flags:            flags,
+		project:          project,
+		expectedModCount: h.modCount,
+	}
+}
+
+// 对下一个元素执行 action
+func (s *spliterator[T, V, R]) TryAdvance(action func(R)) bool {
+	for s.current == nil {
+		if s.bucket >= s.fence {
+			return false
 		}
+		s.current = s.h.data[s.bucket]
+		s.bucket++
+	}
+	e := s.current
+	s.current = e.next
+	if !s.split {
+		s.est--
+	}
+	action(s.project(e))
+	if s.h.modCount != s.expectedModCount {
+		panic(collection.ErrConcurrentModification)
+	}
+	return true
+}
+
+// 对剩余的所有元素执行 action
+func (s *spliterator[T, V, R]) ForEachRemaining(action func(R)) {
+	for s.TryAdvance(action) {
+	}
+}
+
+// 将前一半桶拆分为新的 Spliterator
+func (s *spliterator[T, V, R]) TrySplit() collection.Spliterator[R] {
+	lo, mid := s.bucket, int(uint(s.bucket+s.fence)>>1)
+	if lo >= mid || s.current != nil {
+		return nil
+	}
+	s.bucket = mid
+	s.est >>= 1
+	s.split = true
+	prefix := *s
+	prefix.bucket, prefix.fence = lo, mid
+	return &prefix
+}
+
+// 返回剩余元素数量的估计值
+func (s *spliterator[T, V, R]) EstimateSize() int {
+	return s.est
+}
+
+// 返回 Spliterator 的特征，未拆分时元素数量是精确的
+func (s *spliterator[T, V, R]) Characteristics() collection.Characteristics {
+	if s.split {
+		return s.flags
 	}
+	return s.flags | collection.Sized
 }
@@ -0,0 +1,64 @@
+package hashmap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/lang"
+)
+
+// caseInsensitive 是忽略大小写比较的字符串，实现了 lang.Hashable。
+type caseInsensitive string
+
+func (s caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+func (s caseInsensitive) HashCode() uint64 {
+	return lang.String(strings.ToLower(string(s))).HashCode()
+}
+
+// 键实现了 lang.Hashable 时按 Equals 与 HashCode 判断键是否相同，扩容后仍然如此。
+func TestHashableKeys(t *testing.T) {
+	m := NewHashMap[caseInsensitive, int]()
+	m.Put("Go", 1)
+	m.Put("GO", 2)
+	if m.Size() != 1 {
+		t.Fatalf("Size() = %d, want 1", m.Size())
+	}
+	if v, ok := m.Get("go"); !ok || v != 2 {
+		t.Fatalf("Get(go) = %v, %v, want 2, true", v, ok)
+	}
+	// 与 Java 一样，更新已有键的值时保留原来的键
+	for key := range m.Keys() {
+		if key != "Go" {
+			t.Fatalf("key = %q, want the first inserted key Go", key)
+		}
+	}
+
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	for i := 0; i < 1000; i++ {
+		m.Put(caseInsensitive(fmt.Sprintf("%s-%d", words[i%len(words)], i)), i)
+	}
+	for i := 0; i < 1000; i++ {
+		key := caseInsensitive(strings.ToUpper(fmt.Sprintf("%s-%d", words[i%len(words)], i)))
+		if v, ok := m.Get(key); !ok || v != i {
+			t.Fatalf("Get(%s) = %v, %v, want %d, true", key, v, ok, i)
+		}
+	}
+	if !m.ContainsKey("gO") || !m.Delete("gO") || m.ContainsKey("Go") || m.Delete("go") {
+		t.Fatal("ContainsKey/Delete ignore Equals")
+	}
+	if m.Size() != 1000 {
+		t.Fatalf("Size() = %d, want 1000", m.Size())
+	}
+
+	upper := NewHashMap[caseInsensitive, int]()
+	for key, value := range m.All() {
+		upper.Put(caseInsensitive(strings.ToUpper(string(key))), value)
+	}
+	if !m.Equals(upper) || !upper.Equals(m) || m.HashCode() != upper.HashCode() {
+		t.Fatal("maps whose keys differ only in case are not equal")
+	}
+}
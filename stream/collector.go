@@ -0,0 +1,22 @@
+package stream
+
+// Collector 描述如何将流中的元素归约为结果，对应 Java 的 java.util.stream.Collector。
+//
+// A 是归约过程中的中间容器类型，R 是最终结果类型。Accumulator 与 Combiner 返回更新后的容器，
+// 因此既可以使用指针等可变容器，也可以直接使用 int 之类的值类型。
+type Collector[T any, A any, R any] struct {
+	Supplier    func() A     // 创建一个新的中间容器
+	Accumulator func(A, T) A // 将一个元素并入中间容器
+	Combiner    func(A, A) A // 合并两个中间容器，后一个容器的元素在遭遇顺序上位于前一个之后
+	Finisher    func(A) R    // 将中间容器转换为最终结果
+}
+
+// NewCollector 创建一个中间容器即为最终结果的 Collector。
+func NewCollector[T any, A any](supplier func() A, accumulator func(A, T) A, combiner func(A, A) A) Collector[T, A, A] {
+	return Collector[T, A, A]{
+		Supplier:    supplier,
+		Accumulator: accumulator,
+		Combiner:    combiner,
+		Finisher:    func(container A) A { return container },
+	}
+}
@@ -0,0 +1,283 @@
+package stream
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/collection/list/arraylist"
+	"github.com/herry-hu/go-collections-java/collection/set/hashset"
+	"github.com/herry-hu/go-collections-java/collection/set/treeset"
+	"github.com/herry-hu/go-collections-java/lang"
+	"github.com/herry-hu/go-collections-java/map/hashmap"
+	"strings"
+)
+
+// ToList 返回将元素按遭遇顺序收集到 ArrayList 的 Collector。
+func ToList[T comparable]() Collector[T, *arraylist.ArrayList[T], *arraylist.ArrayList[T]] {
+	return NewCollector(
+		arraylist.NewArrayList[T],
+		func(list *arraylist.ArrayList[T], item T) *arraylist.ArrayList[T] {
+			list.Add(item)
+			return list
+		},
+		func(left, right *arraylist.ArrayList[T]) *arraylist.ArrayList[T] {
+			left.AddAll(right.ToSlice()...)
+			return left
+		},
+	)
+}
+
+// ToSet 返回将元素收集到 HashSet 的 Collector。
+func ToSet[T comparable]() Collector[T, *hashset.HashSet[T], *hashset.HashSet[T]] {
+	return NewCollector(
+		hashset.NewHashSet[T],
+		func(set *hashset.HashSet[T], item T) *hashset.HashSet[T] {
+			set.Add(item)
+			return set
+		},
+		func(left, right *hashset.HashSet[T]) *hashset.HashSet[T] {
+			left.AddAll(right.ToSlice()...)
+			return left
+		},
+	)
+}
+
+// ToTreeSet 返回将元素收集到按自然顺序排列的 TreeSet 的 Collector。
+func ToTreeSet[T lang.Comparable[T]]() Collector[T, *treeset.TreeSet[T], *treeset.TreeSet[T]] {
+	return ToTreeSetFunc(lang.ComparableOrder[T]())
+}
+
+// ToTreeSetFunc 返回将元素收集到按比较函数排列的 TreeSet 的 Collector。
+func ToTreeSetFunc[T any](cmp func(a, b T) int) Collector[T, *treeset.TreeSet[T], *treeset.TreeSet[T]] {
+	return NewCollector(
+		func() *treeset.TreeSet[T] { return treeset.NewTreeSetFunc(cmp) },
+		func(set *treeset.TreeSet[T], item T) *treeset.TreeSet[T] {
+			set.Add(item)
+			return set
+		},
+		func(left, right *treeset.TreeSet[T]) *treeset.TreeSet[T] {
+			left.AddAll(right.ToSlice()...)
+			return left
+		},
+	)
+}
+
+// ToMap 返回将元素收集到 HashMap 的 Collector。
+//
+// 多个元素映射到同一个键时使用 merge(旧值, 新值) 的结果作为该键的值；merge 为nil时遇到重复键会以 collection.ErrIllegalState panic，与 Java 一致。
+func ToMap[T any, K comparable, V comparable](keyMapper func(T) K, valueMapper func(T) V, merge func(V, V) V) Collector[T, *hashmap.HashMap[K, V], *hashmap.HashMap[K, V]] {
+	put := func(m *hashmap.HashMap[K, V], key K, value V) {
+		if old, found := m.Get(key); found {
+			if merge == nil {
+				panic(fmt.Errorf("%w: duplicate key %v", collection.ErrIllegalState, key))
+			}
+			value = merge(old, value)
+		}
+		m.Put(key, value)
+	}
+	return NewCollector(
+		hashmap.NewHashMap[K, V],
+		func(m *hashmap.HashMap[K, V], item T) *hashmap.HashMap[K, V] {
+			put(m, keyMapper(item), valueMapper(item))
+			return m
+		},
+		func(left, right *hashmap.HashMap[K, V]) *hashmap.HashMap[K, V] {
+			for key, value := range right.All() {
+				put(left, key, value)
+			}
+			return left
+		},
+	)
+}
+
+// Groups 是 GroupingBy 的中间容器，保存每个键对应的下游中间容器。
+//
+// 与结果 HashMap 一样，键实现了 lang.Hashable 时按其 Equals 与 HashCode 判断是否相同，
+// 因此 Equals 相等但 == 不相等的键会归入同一组；下游中间容器 A 不必是可比较的类型。
+type Groups[K comparable, A any] struct {
+	index   map[uint64][]int // 键的哈希码到 entries 下标的映射
+	entries []group[K, A]    // 按键首次出现的顺序保存每一组
+}
+
+// group 是 Groups 中的一组。
+type group[K comparable, A any] struct {
+	key       K
+	container A
+}
+
+// find 返回 key 对应的组在 entries 中的下标，不存在时返回-1。
+func (g *Groups[K, A]) find(key K, hash uint64) int {
+	for _, i := range g.index[hash] {
+		if collection.Equal(key, g.entries[i].key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// add 添加一个新的组。
+func (g *Groups[K, A]) add(key K, hash uint64, container A) {
+	g.index[hash] = append(g.index[hash], len(g.entries))
+	g.entries = append(g.entries, group[K, A]{key, container})
+}
+
+// GroupingBy 返回按 classifier 分组、并用 downstream 归约每一组元素的 Collector，结果为键到归约结果的 HashMap。
+//
+// 例如 GroupingBy(classifier, ToList[T]()) 得到每个键对应的 ArrayList。downstream 的 Combiner 为nil时，
+// 返回的 Collector 的 Combiner 也为nil。
+func GroupingBy[T any, K comparable, A any, D comparable](classifier func(T) K, downstream Collector[T, A, D]) Collector[T, *Groups[K, A], *hashmap.HashMap[K, D]] {
+	collector := Collector[T, *Groups[K, A], *hashmap.HashMap[K, D]]{
+		Supplier: func() *Groups[K, A] {
+			return &Groups[K, A]{index: make(map[uint64][]int)}
+		},
+		Accumulator: func(groups *Groups[K, A], item T) *Groups[K, A] {
+			key := classifier(item)
+			hash := collection.HashCode(key)
+			if i := groups.find(key, hash); i >= 0 {
+				groups.entries[i].container = downstream.Accumulator(groups.entries[i].container, item)
+			} else {
+				groups.add(key, hash, downstream.Accumulator(downstream.Supplier(), item))
+			}
+			return groups
+		},
+		Combiner: func(left, right *Groups[K, A]) *Groups[K, A] {
+			for _, g := range right.entries {
+				hash := collection.HashCode(g.key)
+				if i := left.find(g.key, hash); i >= 0 {
+					left.entries[i].container = downstream.Combiner(left.entries[i].container, g.container)
+				} else {
+					left.add(g.key, hash, g.container)
+				}
+			}
+			return left
+		},
+		Finisher: func(groups *Groups[K, A]) *hashmap.HashMap[K, D] {
+			result := hashmap.NewHashMap[K, D]()
+			for _, g := range groups.entries {
+				result.Put(g.key, downstream.Finisher(g.container))
+			}
+			return result
+		},
+	}
+	if downstream.Combiner == nil {
+		// 下游无法合并时让 Collect 顺序归约，而不是在合并时调用nil函数
+		collector.Combiner = nil
+	}
+	return collector
+}
+
+// Partition 是 PartitioningBy 的中间容器，分别保存不满足和满足断言的元素的下游中间容器。
+type Partition[A any] struct {
+	rejected A
+	accepted A
+}
+
+// PartitioningBy 返回按 predicate 将元素分为两组、并用 downstream 归约每一组的 Collector。
+//
+// 结果总是同时包含键 true 和 false，即使某一组没有任何元素。downstream 的 Combiner 为nil时，
+// 返回的 Collector 的 Combiner 也为nil。
+func PartitioningBy[T any, A any, D comparable](predicate func(T) bool, downstream Collector[T, A, D]) Collector[T, *Partition[A], *hashmap.HashMap[bool, D]] {
+	collector := Collector[T, *Partition[A], *hashmap.HashMap[bool, D]]{
+		Supplier: func() *Partition[A] {
+			return &Partition[A]{rejected: downstream.Supplier(), accepted: downstream.Supplier()}
+		},
+		Accumulator: func(p *Partition[A], item T) *Partition[A] {
+			if predicate(item) {
+				p.accepted = downstream.Accumulator(p.accepted, item)
+			} else {
+				p.rejected = downstream.Accumulator(p.rejected, item)
+			}
+			return p
+		},
+		Combiner: func(left, right *Partition[A]) *Partition[A] {
+			left.accepted = downstream.Combiner(left.accepted, right.accepted)
+			left.rejected = downstream.Combiner(left.rejected, right.rejected)
+			return left
+		},
+		Finisher: func(p *Partition[A]) *hashmap.HashMap[bool, D] {
+			result := hashmap.NewHashMap[bool, D]()
+			result.Put(false, downstream.Finisher(p.rejected))
+			result.Put(true, downstream.Finisher(p.accepted))
+			return result
+		},
+	}
+	if downstream.Combiner == nil {
+		collector.Combiner = nil
+	}
+	return collector
+}
+
+// Counting 返回统计元素数量的 Collector。
+func Counting[T any]() Collector[T, int, int] {
+	return SummingInt(func(T) int { return 1 })
+}
+
+// SummingInt 返回对 mapper 结果求和的 Collector。
+func SummingInt[T any](mapper func(T) int) Collector[T, int, int] {
+	return NewCollector(
+		func() int { return 0 },
+		func(sum int, item T) int { return sum + mapper(item) },
+		func(left, right int) int { return left + right },
+	)
+}
+
+// Average 是 AveragingDouble 的中间容器，保存和与元素数量。
+type Average struct {
+	sum   float64
+	count int
+}
+
+// AveragingDouble 返回计算 mapper 结果算术平均值的 Collector，没有元素时结果为0。
+func AveragingDouble[T any](mapper func(T) float64) Collector[T, Average, float64] {
+	return Collector[T, Average, float64]{
+		Supplier: func() Average {
+			return Average{}
+		},
+		Accumulator: func(avg Average, item T) Average {
+			return Average{sum: avg.sum + mapper(item), count: avg.count + 1}
+		},
+		Combiner: func(left, right Average) Average {
+			return Average{sum: left.sum + right.sum, count: left.count + right.count}
+		},
+		Finisher: func(avg Average) float64 {
+			if avg.count == 0 {
+				return 0
+			}
+			return avg.sum / float64(avg.count)
+		},
+	}
+}
+
+// Joining 返回用 delimiter 按遭遇顺序连接字符串元素的 Collector。
+func Joining[T ~string](delimiter string) Collector[T, []string, string] {
+	return JoiningWith[T](delimiter, "", "")
+}
+
+// JoiningWith 返回用 delimiter 连接字符串元素、并在结果两端加上 prefix 和 suffix 的 Collector。
+func JoiningWith[T ~string](delimiter, prefix, suffix string) Collector[T, []string, string] {
+	return Collector[T, []string, string]{
+		Supplier: func() []string {
+			return nil
+		},
+		Accumulator: func(parts []string, item T) []string {
+			return append(parts, string(item))
+		},
+		Combiner: func(left, right []string) []string {
+			return append(left, right...)
+		},
+		Finisher: func(parts []string) string {
+			return prefix + strings.Join(parts, delimiter) + suffix
+		},
+	}
+}
+
+// Mapping 返回先用 mapper 转换元素、再交给 downstream 归约的 Collector，通常作为 GroupingBy 的下游使用。
+func Mapping[T any, U any, A any, R any](mapper func(T) U, downstream Collector[U, A, R]) Collector[T, A, R] {
+	return Collector[T, A, R]{
+		Supplier: downstream.Supplier,
+		Accumulator: func(container A, item T) A {
+			return downstream.Accumulator(container, mapper(item))
+		},
+		Combiner: downstream.Combiner,
+		Finisher: downstream.Finisher,
+	}
+}
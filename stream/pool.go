@@ -0,0 +1,205 @@
+package stream
+
+import (
+	"github.com/herry-hu/go-collections-java/collection"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// task 是提交给 pool 的任务，执行时可以通过所在的 worker 派生子任务。
+type task func(w *worker)
+
+// worker 拥有一个任务双端队列：自己从队尾存取（后进先出，保持局部性），窃取者从队首取走（通常是最大的任务）。
+type worker struct {
+	id    int        // worker 在 pool 中的序号
+	pool  *pool      // 所属的 pool
+	lock  sync.Mutex // 保护任务队列
+	tasks []task     // 任务双端队列
+}
+
+// pool 是一次并行求值使用的工作窃取线程池，由 GOMAXPROCS 个 worker 组成，所有任务完成后自动退出。
+type pool struct {
+	workers  []*worker
+	pending  atomic.Int64 // 已派生但尚未执行完的任务数量
+	lock     sync.Mutex   // 与 cond 配合，让空闲的 worker 休眠
+	cond     *sync.Cond   // 有新任务或全部任务完成时唤醒空闲的 worker
+	panicked atomic.Value // 任务中第一次发生的panic，在调用方goroutine中重新抛出
+}
+
+// invoke 在一个新的 pool 中执行 root 及其派生的所有任务，全部完成后返回。
+func invoke(root task) {
+	p := &pool{}
+	p.cond = sync.NewCond(&p.lock)
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		p.workers = append(p.workers, &worker{id: i, pool: p})
+	}
+	p.workers[0].fork(root)
+
+	var wg sync.WaitGroup
+	for _, w := range p.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.run()
+		}()
+	}
+	wg.Wait()
+
+	if r := p.panicked.Load(); r != nil {
+		panic(r.(recovered).value)
+	}
+}
+
+// recovered 包装panic的值，使 atomic.Value 能保存任意类型。
+type recovered struct {
+	value any
+}
+
+// fork 将任务放入当前 worker 的队尾，空闲的 worker 可以窃取它。
+func (w *worker) fork(t task) {
+	w.pool.pending.Add(1)
+	w.lock.Lock()
+	w.tasks = append(w.tasks, t)
+	w.lock.Unlock()
+
+	w.pool.lock.Lock()
+	w.pool.cond.Signal()
+	w.pool.lock.Unlock()
+}
+
+// pop 从当前 worker 的队尾取出任务。
+func (w *worker) pop() task {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	n := len(w.tasks)
+	if n == 0 {
+		return nil
+	}
+	t := w.tasks[n-1]
+	w.tasks[n-1] = nil
+	w.tasks = w.tasks[:n-1]
+	return t
+}
+
+// steal 从当前 worker 的队首取出任务，供其他 worker 调用。
+func (w *worker) steal() task {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.tasks) == 0 {
+		return nil
+	}
+	t := w.tasks[0]
+	w.tasks[0] = nil
+	w.tasks = w.tasks[1:]
+	return t
+}
+
+// run 不断执行自己的任务，自己的队列为空时窃取其他 worker 的任务，直到 pool 中的任务全部完成。
+func (w *worker) run() {
+	p := w.pool
+	for {
+		t := w.pop()
+		if t == nil {
+			t = p.steal(w.id)
+		}
+		if t == nil {
+			if !p.await() {
+				return
+			}
+			continue
+		}
+		w.exec(t)
+	}
+}
+
+// exec 执行一个任务并记录其中发生的panic，保证 pending 计数总能归零。
+func (w *worker) exec(t task) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.pool.panicked.CompareAndSwap(nil, recovered{r})
+		}
+		if w.pool.pending.Add(-1) == 0 {
+			w.pool.lock.Lock()
+			w.pool.cond.Broadcast()
+			w.pool.lock.Unlock()
+		}
+	}()
+	t(w)
+}
+
+// steal 从序号 id 之后的 worker 开始依次尝试窃取任务。
+func (p *pool) steal(id int) task {
+	for i := 1; i < len(p.workers); i++ {
+		if t := p.workers[(id+i)%len(p.workers)].steal(); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// await 阻塞直到有任务可以窃取或全部任务完成，全部完成时返回false。
+func (p *pool) await() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for {
+		if p.pending.Load() == 0 {
+			return false
+		}
+		for _, w := range p.workers {
+			w.lock.Lock()
+			queued := len(w.tasks) > 0
+			w.lock.Unlock()
+			if queued {
+				return true
+			}
+		}
+		p.cond.Wait()
+	}
+}
+
+// node 是分治求值过程中的一个节点，叶子节点保存结果，内部节点按遭遇顺序记录左右两半。
+type node[T any, R any] struct {
+	spl         collection.Spliterator[T]
+	left, right *node[T, R]
+	result      R
+}
+
+// merge 按遭遇顺序合并子树中所有叶子的结果。
+func (n *node[T, R]) merge(combine func(R, R) R) R {
+	if n.left == nil {
+		return n.result
+	}
+	return combine(n.left.merge(combine), n.right.merge(combine))
+}
+
+// evaluate 以分治方式并行处理 spl：过大的部分被拆分，后一半派生为可被窃取的任务，当前 worker 继续处理前一半；
+// 足够小的部分交给 leaf 处理，最后按遭遇顺序用 combine 合并各叶子的结果。
+func evaluate[T any, R any](spl collection.Spliterator[T], leaf func(collection.Spliterator[T]) R, combine func(R, R) R) R {
+	threshold := max(spl.EstimateSize()/(runtime.GOMAXPROCS(0)*4), 1)
+
+	var compute func(n *node[T, R], w *worker)
+	compute = func(n *node[T, R], w *worker) {
+		for n.spl.EstimateSize() > threshold {
+			prefix := n.spl.TrySplit()
+			if prefix == nil {
+				break
+			}
+			n.left = &node[T, R]{spl: prefix}
+			n.right = &node[T, R]{spl: n.spl}
+			n.spl = nil
+
+			right := n.right
+			w.fork(func(w *worker) { compute(right, w) })
+			n = n.left
+		}
+		n.result = leaf(n.spl)
+	}
+
+	root := &node[T, R]{spl: spl}
+	invoke(func(w *worker) { compute(root, w) })
+	return root.merge(combine)
+}
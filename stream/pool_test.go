@@ -0,0 +1,115 @@
+package stream
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// forkTree 派生一棵深度为 depth 的二叉任务树，每个叶子任务执行一次 leaf。
+func forkTree(depth int, leaf func(w *worker)) task {
+	return func(w *worker) {
+		if depth == 0 {
+			leaf(w)
+			return
+		}
+		w.fork(forkTree(depth-1, leaf))
+		w.fork(forkTree(depth-1, leaf))
+	}
+}
+
+func TestInvokeWaitsForAllForkedTasks(t *testing.T) {
+	const depth = 12
+	var leaves atomic.Int64
+	invoke(forkTree(depth, func(*worker) { leaves.Add(1) }))
+
+	if got := leaves.Load(); got != 1<<depth {
+		t.Fatalf("ran %d leaf tasks, want %d", got, 1<<depth)
+	}
+}
+
+func TestInvokeStealsWork(t *testing.T) {
+	if runtime.GOMAXPROCS(0) < 2 {
+		t.Skip("work stealing needs GOMAXPROCS >= 2")
+	}
+	var lock sync.Mutex
+	used := map[int]bool{}
+	// 所有任务都从 worker 0 派生，其他 worker 只能靠窃取拿到任务
+	invoke(func(w *worker) {
+		for i := 0; i < 64; i++ {
+			w.fork(func(w *worker) {
+				time.Sleep(time.Millisecond)
+				lock.Lock()
+				used[w.id] = true
+				lock.Unlock()
+			})
+		}
+	})
+
+	if len(used) < 2 {
+		t.Fatalf("all tasks ran on workers %v, want them spread by stealing", used)
+	}
+}
+
+func TestInvokeRethrowsFirstPanic(t *testing.T) {
+	var finished atomic.Int64
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Fatalf("recovered %v, want boom", r)
+		}
+		// 发生panic的任务不影响其他任务执行完毕，invoke 也不会因 pending 计数无法归零而挂起
+		if got := finished.Load(); got != 1<<8-1 {
+			t.Fatalf("%d other tasks finished, want %d", got, 1<<8-1)
+		}
+	}()
+
+	var leaves atomic.Int64
+	invoke(forkTree(8, func(*worker) {
+		if leaves.Add(1) == 100 {
+			panic("boom")
+		}
+		finished.Add(1)
+	}))
+	t.Fatal("invoke returned without panicking")
+}
+
+func TestEvaluateKeepsEncounterOrder(t *testing.T) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = i
+	}
+	spl := newSliceSpliterator(items, collection.Ordered|collection.Sized)
+	got := evaluate[int, []int](spl, collectSlice[int], func(left, right []int) []int {
+		return append(left, right...)
+	})
+
+	if !slices.Equal(got, items) {
+		t.Fatal("evaluate did not combine leaf results in encounter order")
+	}
+}
+
+// 任务内部再次并行求值（例如嵌套的并行流）时会使用新的 pool，不能死锁。
+func TestNestedParallelStreams(t *testing.T) {
+	outer := make([]int, 64)
+	for i := range outer {
+		outer[i] = i
+	}
+	sums := Map(Of(outer...).Parallel(), func(n int) int {
+		inner := make([]int, n)
+		for i := range inner {
+			inner[i] = i
+		}
+		return Of(inner...).Parallel().Reduce(0, func(a, b int) int { return a + b })
+	}).ToSlice()
+
+	for n, sum := range sums {
+		if want := n * (n - 1) / 2; sum != want {
+			t.Fatalf("sum of 0..%d = %d, want %d", n-1, sum, want)
+		}
+	}
+}
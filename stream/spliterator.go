@@ -0,0 +1,143 @@
+package stream
+
+import (
+	"github.com/herry-hu/go-collections-java/collection"
+	"iter"
+	"slices"
+)
+
+// sliceSpliterator 拆分一个切片，用于 Of 创建的流以及并行流中被物化的中间结果。
+type sliceSpliterator[T any] struct {
+	items []T                        // 剩余的元素
+	flags collection.Characteristics // 元素本身的特征
+}
+
+func newSliceSpliterator[T any](items []T, flags collection.Characteristics) *sliceSpliterator[T] {
+	return &sliceSpliterator[T]{items: items, flags: flags | collection.Sized | collection.Subsized}
+}
+
+func (s *sliceSpliterator[T]) TryAdvance(action func(T)) bool {
+	if len(s.items) == 0 {
+		return false
+	}
+	item := s.items[0]
+	s.items = s.items[1:]
+	action(item)
+	return true
+}
+
+func (s *sliceSpliterator[T]) ForEachRemaining(action func(T)) {
+	for _, item := range s.items {
+		action(item)
+	}
+	s.items = nil
+}
+
+func (s *sliceSpliterator[T]) TrySplit() collection.Spliterator[T] {
+	mid := len(s.items) / 2
+	if mid == 0 {
+		return nil
+	}
+	prefix := &sliceSpliterator[T]{items: s.items[:mid], flags: s.flags}
+	s.items = s.items[mid:]
+	return prefix
+}
+
+func (s *sliceSpliterator[T]) EstimateSize() int {
+	return len(s.items)
+}
+
+func (s *sliceSpliterator[T]) Characteristics() collection.Characteristics {
+	return s.flags
+}
+
+// seqSpliterator 包装一个无法拆分的序列，第一次被使用时把整个序列物化为切片，因此只能用于有限的序列。
+type seqSpliterator[T any] struct {
+	seq   iter.Seq[T]          // 尚未物化的序列
+	items *sliceSpliterator[T] // 物化后的切片
+}
+
+func (s *seqSpliterator[T]) materialize() *sliceSpliterator[T] {
+	if s.items == nil {
+		s.items = newSliceSpliterator(slices.Collect(s.seq), collection.Ordered)
+	}
+	return s.items
+}
+
+func (s *seqSpliterator[T]) TryAdvance(action func(T)) bool {
+	return s.materialize().TryAdvance(action)
+}
+
+func (s *seqSpliterator[T]) ForEachRemaining(action func(T)) {
+	s.materialize().ForEachRemaining(action)
+}
+
+func (s *seqSpliterator[T]) TrySplit() collection.Spliterator[T] {
+	return s.materialize().TrySplit()
+}
+
+func (s *seqSpliterator[T]) EstimateSize() int {
+	return s.materialize().EstimateSize()
+}
+
+func (s *seqSpliterator[T]) Characteristics() collection.Characteristics {
+	return collection.Ordered
+}
+
+// pipeSpliterator 在上游 Spliterator 之上应用一个无状态的中间操作，拆分时与上游一起拆分。
+type pipeSpliterator[S any, T any] struct {
+	src    collection.Spliterator[S]  // 上游
+	sink   func(S, func(T) bool) bool // 将一个上游元素转换为零个或多个下游元素
+	clear  collection.Characteristics // 该操作会破坏的上游特征
+	buffer []T                        // TryAdvance 时一个上游元素产出的多余元素
+}
+
+func (s *pipeSpliterator[S, T]) TryAdvance(action func(T)) bool {
+	for len(s.buffer) == 0 {
+		advanced := s.src.TryAdvance(func(item S) {
+			s.sink(item, func(out T) bool {
+				s.buffer = append(s.buffer, out)
+				return true
+			})
+		})
+		if !advanced {
+			return false
+		}
+	}
+	out := s.buffer[0]
+	s.buffer = s.buffer[1:]
+	action(out)
+	return true
+}
+
+func (s *pipeSpliterator[S, T]) ForEachRemaining(action func(T)) {
+	for _, out := range s.buffer {
+		action(out)
+	}
+	s.buffer = nil
+	s.src.ForEachRemaining(func(item S) {
+		s.sink(item, func(out T) bool {
+			action(out)
+			return true
+		})
+	})
+}
+
+func (s *pipeSpliterator[S, T]) TrySplit() collection.Spliterator[T] {
+	if len(s.buffer) > 0 {
+		return nil
+	}
+	prefix := s.src.TrySplit()
+	if prefix == nil {
+		return nil
+	}
+	return &pipeSpliterator[S, T]{src: prefix, sink: s.sink, clear: s.clear}
+}
+
+func (s *pipeSpliterator[S, T]) EstimateSize() int {
+	return s.src.EstimateSize() + len(s.buffer)
+}
+
+func (s *pipeSpliterator[S, T]) Characteristics() collection.Characteristics {
+	return s.src.Characteristics() &^ s.clear
+}
@@ -0,0 +1,600 @@
+package stream
+
+import (
+	"fmt"
+	"github.com/herry-hu/go-collections-java/collection"
+	"github.com/herry-hu/go-collections-java/lang"
+	"iter"
+	"slices"
+	"sync/atomic"
+)
+
+// Stream 是惰性求值的元素序列，对应 Java 的 java.util.stream.Stream。
+//
+// 中间操作只是组装流水线，直到调用 ForEach、Collect 等终止操作时才会遍历数据源。
+// Go 的方法不能声明额外的类型参数，因此改变元素类型的操作（Map、FlatMap 等）以包级函数提供。
+//
+// 调用 Parallel 后，终止操作会通过数据源的 collection.Spliterator 把数据切分给 GOMAXPROCS 个 worker 并行处理，
+// 除 ForEach 外的终止操作都保持遭遇顺序。并行流要求数据源有限，且遍历期间不被修改。
+type Stream[T any] struct {
+	seq      iter.Seq[T]                      // 顺序执行时产出元素的序列
+	split    func() collection.Spliterator[T] // 并行执行时创建可拆分的数据源
+	parallel bool                             // 终止操作是否并行执行
+}
+
+// FromSeq 创建一个从指定序列读取元素的流。
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{
+		seq: seq,
+		split: func() collection.Spliterator[T] {
+			return &seqSpliterator[T]{seq: seq}
+		},
+	}
+}
+
+// FromSpliterator 创建一个从 Spliterator 读取元素的流，supplier 在终止操作开始时才被调用。
+func FromSpliterator[T any](supplier func() collection.Spliterator[T]) *Stream[T] {
+	return &Stream[T]{
+		seq: func(yield func(T) bool) {
+			spl := supplier()
+			stopped := false
+			for !stopped && spl.TryAdvance(func(item T) { stopped = !yield(item) }) {
+			}
+		},
+		split: supplier,
+	}
+}
+
+// Of 创建一个包含指定元素的流。
+func Of[T any](items ...T) *Stream[T] {
+	return &Stream[T]{
+		seq: slices.Values(items),
+		split: func() collection.Spliterator[T] {
+			return newSliceSpliterator(items, collection.Ordered)
+		},
+	}
+}
+
+// Empty 创建一个不包含任何元素的流。
+func Empty[T any]() *Stream[T] {
+	return Of[T]()
+}
+
+// FromCollection 创建一个按集合遍历顺序读取元素的流，适用于 ArrayList、链表、HashSet 和 TreeSet。
+//
+// 集合提供 Spliterator 方法时并行流直接拆分集合，否则先把集合复制为切片再拆分。
+func FromCollection[T any](c collection.Collection[T]) *Stream[T] {
+	split := func() collection.Spliterator[T] {
+		flags := collection.Ordered
+		if _, ok := c.(collection.Set[T]); ok {
+			flags |= collection.Distinct
+		}
+		return newSliceSpliterator(c.ToSlice(), flags)
+	}
+	if s, ok := c.(interface {
+		Spliterator() collection.Spliterator[T]
+	}); ok {
+		split = s.Spliterator
+	}
+	return &Stream[T]{seq: c.All(), split: split}
+}
+
+// FromMap 创建一个读取映射中所有键值对的流，对应 Java 的 map.entrySet().stream()。
+func FromMap[K comparable, V any](m collection.Map[K, V]) *Stream[collection.Entry[K, V]] {
+	seq := func(yield func(collection.Entry[K, V]) bool) {
+		for key, value := range m.All() {
+			if !yield(collection.Entry[K, V]{Key: key, Value: value}) {
+				return
+			}
+		}
+	}
+	split := func() collection.Spliterator[collection.Entry[K, V]] {
+		return newSliceSpliterator(slices.Collect(seq), collection.Distinct)
+	}
+	if s, ok := m.(interface {
+		EntrySpliterator() collection.Spliterator[collection.Entry[K, V]]
+	}); ok {
+		split = s.EntrySpliterator
+	}
+	return &Stream[collection.Entry[K, V]]{seq: seq, split: split}
+}
+
+// Iterate 创建一个无限流，元素依次为 seed、next(seed)、next(next(seed))……
+func Iterate[T any](seed T, next func(T) T) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for v := seed; yield(v); v = next(v) {
+		}
+	})
+}
+
+// Generate 创建一个由 supplier 不断产出元素的无限流。
+func Generate[T any](supplier func() T) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for yield(supplier()) {
+		}
+	})
+}
+
+// Parallel 返回终止操作并行执行的流。与 Java 一样，该设置作用于整条流水线。
+func (s *Stream[T]) Parallel() *Stream[T] {
+	return &Stream[T]{seq: s.seq, split: s.split, parallel: true}
+}
+
+// Sequential 返回终止操作顺序执行的流。
+func (s *Stream[T]) Sequential() *Stream[T] {
+	return &Stream[T]{seq: s.seq, split: s.split, parallel: false}
+}
+
+// IsParallel 检查终止操作是否会并行执行。
+func (s *Stream[T]) IsParallel() bool {
+	return s.parallel
+}
+
+// Spliterator 返回该流的可拆分数据源。
+func (s *Stream[T]) Spliterator() collection.Spliterator[T] {
+	return s.split()
+}
+
+// All 返回按遭遇顺序产出流中所有元素的序列，可直接用于 for range；即使是并行流也在当前goroutine中顺序遍历。
+func (s *Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// pipe 组装一个无状态的中间操作：sink 把每个上游元素通过 emit 转换为零个或多个下游元素，
+// emit 返回false时表示下游已不再需要元素。clear 是该操作会破坏的上游特征。
+func pipe[S any, T any](s *Stream[S], clear collection.Characteristics, sink func(item S, emit func(T) bool) bool) *Stream[T] {
+	return &Stream[T]{
+		seq: func(yield func(T) bool) {
+			for v := range s.seq {
+				if !sink(v, yield) {
+					return
+				}
+			}
+		},
+		split: func() collection.Spliterator[T] {
+			return &pipeSpliterator[S, T]{src: s.split(), sink: sink, clear: clear}
+		},
+		parallel: s.parallel,
+	}
+}
+
+// stateful 组装一个有状态的中间操作：顺序执行时使用 seq；并行执行时先并行地把上游物化为切片，再用 op 变换。
+func (s *Stream[T]) stateful(seq iter.Seq[T], op func(items []T, flags collection.Characteristics) collection.Spliterator[T]) *Stream[T] {
+	return &Stream[T]{
+		seq: seq,
+		split: func() collection.Spliterator[T] {
+			spl := s.split()
+			return op(toSlice(spl), spl.Characteristics())
+		},
+		parallel: s.parallel,
+	}
+}
+
+// Filter 返回只包含满足 predicate 的元素的流。
+func (s *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+	return pipe(s, collection.Sized|collection.Subsized, func(v T, emit func(T) bool) bool {
+		return !predicate(v) || emit(v)
+	})
+}
+
+// Peek 返回一个在元素被消费时先执行 action 的流，通常用于调试。
+func (s *Stream[T]) Peek(action func(T)) *Stream[T] {
+	return pipe(s, 0, func(v T, emit func(T) bool) bool {
+		action(v)
+		return emit(v)
+	})
+}
+
+// Limit 返回最多包含前 n 个元素的流，n 为负数时以 collection.ErrIllegalArgument panic。
+//
+// 并行流中该操作会顺序地从上游读取前 n 个元素，因此也可以用来截断无限流。
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	if n < 0 {
+		panic(fmt.Errorf("%w: negative limit %d", collection.ErrIllegalArgument, n))
+	}
+	seq := func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		count := 0
+		for v := range s.seq {
+			if !yield(v) {
+				return
+			}
+			if count++; count == n {
+				return
+			}
+		}
+	}
+	return &Stream[T]{
+		seq: seq,
+		split: func() collection.Spliterator[T] {
+			return newSliceSpliterator(slices.Collect(seq), collection.Ordered)
+		},
+		parallel: s.parallel,
+	}
+}
+
+// Skip 返回丢弃前 n 个元素后的流，n 为负数时以 collection.ErrIllegalArgument panic。
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	if n < 0 {
+		panic(fmt.Errorf("%w: negative skip %d", collection.ErrIllegalArgument, n))
+	}
+	seq := func(yield func(T) bool) {
+		skipped := 0
+		for v := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return s.stateful(seq, func(items []T, flags collection.Characteristics) collection.Spliterator[T] {
+		return newSliceSpliterator(items[min(n, len(items)):], flags)
+	})
+}
+
+// TakeWhile 返回由开头连续满足 predicate 的元素组成的流。
+//
+// 并行流中该操作会顺序地从上游读取元素，因此也可以用来截断无限流。
+func (s *Stream[T]) TakeWhile(predicate func(T) bool) *Stream[T] {
+	seq := func(yield func(T) bool) {
+		for v := range s.seq {
+			if !predicate(v) || !yield(v) {
+				return
+			}
+		}
+	}
+	return &Stream[T]{
+		seq: seq,
+		split: func() collection.Spliterator[T] {
+			return newSliceSpliterator(slices.Collect(seq), collection.Ordered)
+		},
+		parallel: s.parallel,
+	}
+}
+
+// DropWhile 返回丢弃开头连续满足 predicate 的元素后的流。
+func (s *Stream[T]) DropWhile(predicate func(T) bool) *Stream[T] {
+	seq := func(yield func(T) bool) {
+		dropping := true
+		for v := range s.seq {
+			if dropping && predicate(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return s.stateful(seq, func(items []T, flags collection.Characteristics) collection.Spliterator[T] {
+		i := 0
+		for i < len(items) && predicate(items[i]) {
+			i++
+		}
+		return newSliceSpliterator(items[i:], flags)
+	})
+}
+
+// Sorted 返回按比较函数稳定排序后的流，cmp 的约定与 slices.SortFunc 相同。
+//
+// 并行流中各 worker 先分别排序自己的部分，再按遭遇顺序两两归并，相等元素保持原有的先后顺序。
+func (s *Stream[T]) Sorted(cmp func(a, b T) int) *Stream[T] {
+	return &Stream[T]{
+		seq: func(yield func(T) bool) {
+			items := slices.Collect(s.seq)
+			slices.SortStableFunc(items, cmp)
+			for _, v := range items {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+		split: func() collection.Spliterator[T] {
+			spl := s.split()
+			items := evaluate(spl, func(leaf collection.Spliterator[T]) []T {
+				items := collectSlice(leaf)
+				slices.SortStableFunc(items, cmp)
+				return items
+			}, func(left, right []T) []T {
+				return mergeSorted(left, right, cmp)
+			})
+			return newSliceSpliterator(items, spl.Characteristics()|collection.Ordered|collection.Sorted)
+		},
+		parallel: s.parallel,
+	}
+}
+
+// ForEach 对流中的每个元素执行指定的操作。并行流中 action 会被多个goroutine并发调用，且不保证顺序。
+func (s *Stream[T]) ForEach(action func(T)) {
+	if s.parallel {
+		evaluate(s.split(), func(leaf collection.Spliterator[T]) struct{} {
+			leaf.ForEachRemaining(action)
+			return struct{}{}
+		}, func(struct{}, struct{}) struct{} { return struct{}{} })
+		return
+	}
+	for v := range s.seq {
+		action(v)
+	}
+}
+
+// ForEachOrdered 按遭遇顺序在当前goroutine中对每个元素执行指定的操作，并行流中上游仍然并行计算。
+func (s *Stream[T]) ForEachOrdered(action func(T)) {
+	if s.parallel {
+		for _, v := range s.ToSlice() {
+			action(v)
+		}
+		return
+	}
+	s.ForEach(action)
+}
+
+// Reduce 以 identity 为初始值，用 op 依次累积流中的元素。
+//
+// 并行流中每个部分都从 identity 开始累积，再按遭遇顺序用 op 合并，因此要求 op 满足结合律且 identity 是单位元。
+func (s *Stream[T]) Reduce(identity T, op func(T, T) T) T {
+	if s.parallel {
+		return evaluate(s.split(), func(leaf collection.Spliterator[T]) T {
+			result := identity
+			leaf.ForEachRemaining(func(v T) { result = op(result, v) })
+			return result
+		}, op)
+	}
+	result := identity
+	for v := range s.seq {
+		result = op(result, v)
+	}
+	return result
+}
+
+// ReduceOpt 用 op 依次累积流中的元素，不需要初始值；流为空时返回 lang.Empty。op 需要满足结合律。
+func (s *Stream[T]) ReduceOpt(op func(T, T) T) lang.Optional[T] {
+	accumulate := func(acc lang.Optional[T], v T) lang.Optional[T] {
+		if acc.IsPresent() {
+			return lang.Of(op(acc.Get(), v))
+		}
+		return lang.Of(v)
+	}
+	if s.parallel {
+		return evaluate(s.split(), func(leaf collection.Spliterator[T]) lang.Optional[T] {
+			result := lang.Empty[T]()
+			leaf.ForEachRemaining(func(v T) { result = accumulate(result, v) })
+			return result
+		}, func(left, right lang.Optional[T]) lang.Optional[T] {
+			if right.IsPresent() {
+				return accumulate(left, right.Get())
+			}
+			return left
+		})
+	}
+	result := lang.Empty[T]()
+	for v := range s.seq {
+		result = accumulate(result, v)
+	}
+	return result
+}
+
+// Count 返回流中元素的数量。
+func (s *Stream[T]) Count() int {
+	if s.parallel {
+		return evaluate(s.split(), func(leaf collection.Spliterator[T]) int {
+			count := 0
+			leaf.ForEachRemaining(func(T) { count++ })
+			return count
+		}, func(left, right int) int { return left + right })
+	}
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// AnyMatch 检查是否存在满足 predicate 的元素，找到后立即停止遍历。
+func (s *Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	if s.parallel {
+		var found atomic.Bool
+		evaluate(s.split(), func(leaf collection.Spliterator[T]) struct{} {
+			for !found.Load() && leaf.TryAdvance(func(v T) {
+				if predicate(v) {
+					found.Store(true)
+				}
+			}) {
+			}
+			return struct{}{}
+		}, func(struct{}, struct{}) struct{} { return struct{}{} })
+		return found.Load()
+	}
+	for v := range s.seq {
+		if predicate(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch 检查是否所有元素都满足 predicate，空流返回true。
+func (s *Stream[T]) AllMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(func(v T) bool { return !predicate(v) })
+}
+
+// NoneMatch 检查是否没有元素满足 predicate，空流返回true。
+func (s *Stream[T]) NoneMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// FindFirst 返回流中的第一个元素，流为空时返回零值和false。
+func (s *Stream[T]) FindFirst() (T, bool) {
+	if s.parallel {
+		type first struct {
+			value T
+			found bool
+		}
+		result := evaluate(s.split(), func(leaf collection.Spliterator[T]) first {
+			var f first
+			leaf.TryAdvance(func(v T) { f = first{value: v, found: true} })
+			return f
+		}, func(left, right first) first {
+			if left.found {
+				return left
+			}
+			return right
+		})
+		return result.value, result.found
+	}
+	for v := range s.seq {
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindFirstOpt 以 lang.Optional 返回流中的第一个元素，流为空时返回 lang.Empty。
+func (s *Stream[T]) FindFirstOpt() lang.Optional[T] {
+	return lang.OfOk(s.FindFirst())
+}
+
+// ToSlice 将流中的元素按遭遇顺序收集到一个新的切片中。
+func (s *Stream[T]) ToSlice() []T {
+	if s.parallel {
+		return toSlice(s.split())
+	}
+	return slices.Collect(s.seq)
+}
+
+// Map 返回对每个元素应用 mapper 后得到的流。
+func Map[T any, R any](s *Stream[T], mapper func(T) R) *Stream[R] {
+	return pipe(s, collection.Distinct|collection.Sorted, func(v T, emit func(R) bool) bool {
+		return emit(mapper(v))
+	})
+}
+
+// FlatMap 返回将每个元素映射为一个流后再依次展开得到的流。
+func FlatMap[T any, R any](s *Stream[T], mapper func(T) *Stream[R]) *Stream[R] {
+	clear := collection.Distinct | collection.Sorted | collection.Sized | collection.Subsized
+	return pipe(s, clear, func(v T, emit func(R) bool) bool {
+		for r := range mapper(v).seq {
+			if !emit(r) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Distinct 返回去除重复元素后的流，保留每个元素第一次出现的位置。
+//
+// 与 GroupingBy 一样，元素实现了 lang.Hashable 时按其 Equals 与 HashCode 判断是否重复。
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	seq := func(yield func(T) bool) {
+		seen := newSeenSet[T]()
+		for v := range s.seq {
+			if seen.addIfAbsent(v) && !yield(v) {
+				return
+			}
+		}
+	}
+	return &Stream[T]{
+		seq: seq,
+		split: func() collection.Spliterator[T] {
+			spl := s.split()
+			if spl.Characteristics().Has(collection.Distinct) {
+				return spl // 数据源本身没有重复元素
+			}
+			items := toSlice(spl)
+			seen := newSeenSet[T]()
+			unique := items[:0]
+			for _, v := range items {
+				if seen.addIfAbsent(v) {
+					unique = append(unique, v)
+				}
+			}
+			return newSliceSpliterator(unique, spl.Characteristics()|collection.Distinct)
+		},
+		parallel: s.parallel,
+	}
+}
+
+// seenSet 记录 Distinct 已经产出的元素，复用 Groups 按哈希码索引、按 collection.Equal 比较的查找方式。
+type seenSet[T comparable] struct {
+	groups Groups[T, struct{}]
+}
+
+func newSeenSet[T comparable]() *seenSet[T] {
+	return &seenSet[T]{groups: Groups[T, struct{}]{index: make(map[uint64][]int)}}
+}
+
+// addIfAbsent 在 v 第一次出现时记录它并返回true。
+func (s *seenSet[T]) addIfAbsent(v T) bool {
+	hash := collection.HashCode(v)
+	if s.groups.find(v, hash) >= 0 {
+		return false
+	}
+	s.groups.add(v, hash, struct{}{})
+	return true
+}
+
+// SortedNatural 返回按元素自然顺序（lang.Comparable[T]）稳定排序后的流。
+func SortedNatural[T lang.Comparable[T]](s *Stream[T]) *Stream[T] {
+	return s.Sorted(func(a, b T) int {
+		return a.CompareTo(b)
+	})
+}
+
+// Collect 使用 collector 将流中的元素归约为结果。
+//
+// 并行流中每个部分各自创建中间容器，再按遭遇顺序用 Combiner 合并；Combiner 为nil时先并行物化元素，再顺序归约。
+func Collect[T any, A any, R any](s *Stream[T], collector Collector[T, A, R]) R {
+	accumulate := func(spl collection.Spliterator[T]) A {
+		container := collector.Supplier()
+		spl.ForEachRemaining(func(v T) { container = collector.Accumulator(container, v) })
+		return container
+	}
+	if s.parallel {
+		if collector.Combiner == nil {
+			return collector.Finisher(accumulate(newSliceSpliterator(s.ToSlice(), collection.Ordered)))
+		}
+		return collector.Finisher(evaluate(s.split(), accumulate, collector.Combiner))
+	}
+	container := collector.Supplier()
+	for v := range s.seq {
+		container = collector.Accumulator(container, v)
+	}
+	return collector.Finisher(container)
+}
+
+// toSlice 并行地把 spl 中的元素按遭遇顺序收集到切片中。
+func toSlice[T any](spl collection.Spliterator[T]) []T {
+	return evaluate(spl, collectSlice[T], func(left, right []T) []T {
+		return append(left, right...)
+	})
+}
+
+// collectSlice 把 spl 中剩余的元素收集到切片中。
+func collectSlice[T any](spl collection.Spliterator[T]) []T {
+	items := make([]T, 0, spl.EstimateSize())
+	spl.ForEachRemaining(func(v T) { items = append(items, v) })
+	return items
+}
+
+// mergeSorted 归并两个已排序的切片，相等时 left 中的元素在前以保持稳定性。
+func mergeSorted[T any](left, right []T, cmp func(a, b T) int) []T {
+	merged := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		if cmp(right[j], left[i]) < 0 {
+			merged = append(merged, right[j])
+			j++
+		} else {
+			merged = append(merged, left[i])
+			i++
+		}
+	}
+	merged = append(merged, left[i:]...)
+	return append(merged, right[j:]...)
+}
@@ -0,0 +1,97 @@
+package stream
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/herry-hu/go-collections-java/collection"
+)
+
+// caseInsensitive 是忽略大小写比较的字符串，实现了 lang.Hashable。
+type caseInsensitive string
+
+func (s caseInsensitive) Equals(other caseInsensitive) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+func (s caseInsensitive) HashCode() uint64 {
+	var hash uint64
+	for _, r := range strings.ToLower(string(s)) {
+		hash = 31*hash + uint64(r)
+	}
+	return hash
+}
+
+func TestDistinctUsesEquals(t *testing.T) {
+	items := []caseInsensitive{"Go", "java", "GO", "Java", "rust", "go", "RUST"}
+	want := []caseInsensitive{"Go", "java", "rust"}
+	if got := Distinct(Of(items...)).ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("sequential Distinct = %v, want %v", got, want)
+	}
+	if got := Distinct(Of(items...).Parallel()).ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("parallel Distinct = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctKeepsFirstOccurrence(t *testing.T) {
+	items := make([]int, 10000)
+	for i := range items {
+		items[i] = (i * 7) % 100
+	}
+	want := slices.Clone(items[:100]) // 7 与 100 互素，前 100 个元素互不相同
+	for _, s := range []*Stream[int]{Of(items...), Of(items...).Parallel()} {
+		if got := Distinct(s).ToSlice(); !slices.Equal(got, want) {
+			t.Errorf("Distinct (parallel=%v) = %v, want %v", s.IsParallel(), got, want)
+		}
+	}
+}
+
+func TestLimitAndSkip(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	for _, parallel := range []bool{false, true} {
+		s := func() *Stream[int] {
+			if parallel {
+				return Of(items...).Parallel()
+			}
+			return Of(items...)
+		}
+		tests := []struct {
+			name string
+			got  []int
+			want []int
+		}{
+			{"Limit(0)", s().Limit(0).ToSlice(), []int{}},
+			{"Limit(3)", s().Limit(3).ToSlice(), []int{1, 2, 3}},
+			{"Limit(10)", s().Limit(10).ToSlice(), items},
+			{"Skip(0)", s().Skip(0).ToSlice(), items},
+			{"Skip(2)", s().Skip(2).ToSlice(), []int{3, 4, 5}},
+			{"Skip(10)", s().Skip(10).ToSlice(), []int{}},
+			{"Skip(1).Limit(2)", s().Skip(1).Limit(2).ToSlice(), []int{2, 3}},
+		}
+		for _, tt := range tests {
+			if !slices.Equal(tt.got, tt.want) {
+				t.Errorf("%s (parallel=%v) = %v, want %v", tt.name, parallel, tt.got, tt.want)
+			}
+		}
+	}
+	// Limit 可以截断无限流
+	if got := Iterate(1, func(n int) int { return n * 2 }).Parallel().Limit(5).ToSlice(); !slices.Equal(got, []int{1, 2, 4, 8, 16}) {
+		t.Errorf("Iterate(...).Limit(5) = %v", got)
+	}
+
+	for name, f := range map[string]func(){
+		"Limit(-1)": func() { Of(1).Limit(-1) },
+		"Skip(-1)":  func() { Of(1).Skip(-1) },
+	} {
+		func() {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, collection.ErrIllegalArgument) {
+					t.Errorf("%s: panic = %v, want ErrIllegalArgument", name, err)
+				}
+			}()
+			f()
+		}()
+	}
+}